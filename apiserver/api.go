@@ -14,6 +14,18 @@ import (
 	"net/http"
 )
 
+// AnalyticsApiRouter defines the required methods for binding the api requests to a responses for the AnalyticsApi
+// The AnalyticsApiRouter implementation should parse necessary information from the http request,
+// pass the data to a AnalyticsApiServicer to perform the required actions, then write the service results to the http response.
+type AnalyticsApiRouter interface {
+	GetDeviceDwellTimes(http.ResponseWriter, *http.Request)
+	GetHeatmap(http.ResponseWriter, *http.Request)
+	GetHeatmapImage(http.ResponseWriter, *http.Request)
+	GetRoomDwellTimes(http.ResponseWriter, *http.Request)
+	GetTrajectory(http.ResponseWriter, *http.Request)
+	GetTrajectoryGeoJson(http.ResponseWriter, *http.Request)
+}
+
 // ConfigurationApiRouter defines the required methods for binding the api requests to a responses for the ConfigurationApi
 // The ConfigurationApiRouter implementation should parse necessary information from the http request,
 // pass the data to a ConfigurationApiServicer to perform the required actions, then write the service results to the http response.
@@ -22,7 +34,11 @@ type ConfigurationApiRouter interface {
 	GetConfigurationById(http.ResponseWriter, *http.Request)
 	GetConfigurations(http.ResponseWriter, *http.Request)
 	PostConfiguration(http.ResponseWriter, *http.Request)
+	PreviewAssetFilter(http.ResponseWriter, *http.Request)
+	PreviewAssetFilterById(http.ResponseWriter, *http.Request)
 	PutConfigurationById(http.ResponseWriter, *http.Request)
+	TestConnection(http.ResponseWriter, *http.Request)
+	TestConnectionById(http.ResponseWriter, *http.Request)
 }
 
 // CustomizationApiRouter defines the required methods for binding the api requests to a responses for the CustomizationApi
@@ -32,6 +48,57 @@ type CustomizationApiRouter interface {
 	GetDashboardTemplateByName(http.ResponseWriter, *http.Request)
 }
 
+// FloorHeightsApiRouter defines the required methods for binding the api requests to a responses for the FloorHeightsApi
+// The FloorHeightsApiRouter implementation should parse necessary information from the http request,
+// pass the data to a FloorHeightsApiServicer to perform the required actions, then write the service results to the http response.
+type FloorHeightsApiRouter interface {
+	GetFloorHeights(http.ResponseWriter, *http.Request)
+	PutFloorHeight(http.ResponseWriter, *http.Request)
+}
+
+// FloorPlansApiRouter defines the required methods for binding the api requests to a responses for the FloorPlansApi
+// The FloorPlansApiRouter implementation should parse necessary information from the http request,
+// pass the data to a FloorPlansApiServicer to perform the required actions, then write the service results to the http response.
+type FloorPlansApiRouter interface {
+	GetFloorPlan(http.ResponseWriter, *http.Request)
+	GetFloorPlanImage(http.ResponseWriter, *http.Request)
+	GetFloorPlans(http.ResponseWriter, *http.Request)
+}
+
+// HealthApiRouter defines the required methods for binding the api requests to a responses for the HealthApi
+// The HealthApiRouter implementation should parse necessary information from the http request,
+// pass the data to a HealthApiServicer to perform the required actions, then write the service results to the http response.
+type HealthApiRouter interface {
+	GetLiveness(http.ResponseWriter, *http.Request)
+	GetReadiness(http.ResponseWriter, *http.Request)
+}
+
+// InventoryApiRouter defines the required methods for binding the api requests to a responses for the InventoryApi
+// The InventoryApiRouter implementation should parse necessary information from the http request,
+// pass the data to a InventoryApiServicer to perform the required actions, then write the service results to the http response.
+type InventoryApiRouter interface {
+	GetInventoryDevices(http.ResponseWriter, *http.Request)
+	GetInventoryLocations(http.ResponseWriter, *http.Request)
+}
+
+// MaintenanceApiRouter defines the required methods for binding the api requests to a responses for the MaintenanceApi
+// The MaintenanceApiRouter implementation should parse necessary information from the http request,
+// pass the data to a MaintenanceApiServicer to perform the required actions, then write the service results to the http response.
+type MaintenanceApiRouter interface {
+	GetBatteryForecasts(http.ResponseWriter, *http.Request)
+	GetFirmwareInventory(http.ResponseWriter, *http.Request)
+}
+
+// SyncApiRouter defines the required methods for binding the api requests to a responses for the SyncApi
+// The SyncApiRouter implementation should parse necessary information from the http request,
+// pass the data to a SyncApiServicer to perform the required actions, then write the service results to the http response.
+type SyncApiRouter interface {
+	GetSyncRun(http.ResponseWriter, *http.Request)
+	GetSyncRuns(http.ResponseWriter, *http.Request)
+	GetSyncStatus(http.ResponseWriter, *http.Request)
+	TriggerSync(http.ResponseWriter, *http.Request)
+}
+
 // VersionApiRouter defines the required methods for binding the api requests to a responses for the VersionApi
 // The VersionApiRouter implementation should parse necessary information from the http request,
 // pass the data to a VersionApiServicer to perform the required actions, then write the service results to the http response.
@@ -40,6 +107,30 @@ type VersionApiRouter interface {
 	GetVersion(http.ResponseWriter, *http.Request)
 }
 
+// ZonesApiRouter defines the required methods for binding the api requests to a responses for the ZonesApi
+// The ZonesApiRouter implementation should parse necessary information from the http request,
+// pass the data to a ZonesApiServicer to perform the required actions, then write the service results to the http response.
+type ZonesApiRouter interface {
+	DeleteZoneById(http.ResponseWriter, *http.Request)
+	GetZoneById(http.ResponseWriter, *http.Request)
+	GetZones(http.ResponseWriter, *http.Request)
+	PostZone(http.ResponseWriter, *http.Request)
+	PutZoneById(http.ResponseWriter, *http.Request)
+}
+
+// AnalyticsApiServicer defines the api actions for the AnalyticsApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type AnalyticsApiServicer interface {
+	GetDeviceDwellTimes(context.Context, int64, string, string) (ImplResponse, error)
+	GetHeatmap(context.Context, int64, int32, string, string, float64) (ImplResponse, error)
+	GetHeatmapImage(context.Context, int64, int32, string, string, float64) (ImplResponse, error)
+	GetRoomDwellTimes(context.Context, int64, string, string) (ImplResponse, error)
+	GetTrajectory(context.Context, int64, string, string, string, int32) (ImplResponse, error)
+	GetTrajectoryGeoJson(context.Context, int64, string, string, string, int32) (ImplResponse, error)
+}
+
 // ConfigurationApiServicer defines the api actions for the ConfigurationApi service
 // This interface intended to stay up to date with the openapi yaml used to generate it,
 // while the service implementation can be ignored with the .openapi-generator-ignore file
@@ -49,7 +140,11 @@ type ConfigurationApiServicer interface {
 	GetConfigurationById(context.Context, int64) (ImplResponse, error)
 	GetConfigurations(context.Context) (ImplResponse, error)
 	PostConfiguration(context.Context, Configuration) (ImplResponse, error)
+	PreviewAssetFilter(context.Context, Configuration) (ImplResponse, error)
+	PreviewAssetFilterById(context.Context, int64, *[][]FilterRule) (ImplResponse, error)
 	PutConfigurationById(context.Context, int64, Configuration) (ImplResponse, error)
+	TestConnection(context.Context, Configuration) (ImplResponse, error)
+	TestConnectionById(context.Context, int64) (ImplResponse, error)
 }
 
 // CustomizationApiServicer defines the api actions for the CustomizationApi service
@@ -60,6 +155,63 @@ type CustomizationApiServicer interface {
 	GetDashboardTemplateByName(context.Context, string, string) (ImplResponse, error)
 }
 
+// FloorHeightsApiServicer defines the api actions for the FloorHeightsApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type FloorHeightsApiServicer interface {
+	GetFloorHeights(context.Context, int64) (ImplResponse, error)
+	PutFloorHeight(context.Context, int32, FloorHeight) (ImplResponse, error)
+}
+
+// FloorPlansApiServicer defines the api actions for the FloorPlansApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type FloorPlansApiServicer interface {
+	GetFloorPlan(context.Context, int64, int32) (ImplResponse, error)
+	GetFloorPlanImage(context.Context, int64, int32) (ImplResponse, error)
+	GetFloorPlans(context.Context, int64) (ImplResponse, error)
+}
+
+// HealthApiServicer defines the api actions for the HealthApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type HealthApiServicer interface {
+	GetLiveness(context.Context) (ImplResponse, error)
+	GetReadiness(context.Context) (ImplResponse, error)
+}
+
+// InventoryApiServicer defines the api actions for the InventoryApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type InventoryApiServicer interface {
+	GetInventoryDevices(context.Context, int64) (ImplResponse, error)
+	GetInventoryLocations(context.Context, int64) (ImplResponse, error)
+}
+
+// MaintenanceApiServicer defines the api actions for the MaintenanceApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type MaintenanceApiServicer interface {
+	GetBatteryForecasts(context.Context, int64, int32) (ImplResponse, error)
+	GetFirmwareInventory(context.Context, int64) (ImplResponse, error)
+}
+
+// SyncApiServicer defines the api actions for the SyncApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type SyncApiServicer interface {
+	GetSyncRun(context.Context, int64, int64) (ImplResponse, error)
+	GetSyncRuns(context.Context, int64, int32) (ImplResponse, error)
+	GetSyncStatus(context.Context, int64) (ImplResponse, error)
+	TriggerSync(context.Context, int64, string) (ImplResponse, error)
+}
+
 // VersionApiServicer defines the api actions for the VersionApi service
 // This interface intended to stay up to date with the openapi yaml used to generate it,
 // while the service implementation can be ignored with the .openapi-generator-ignore file
@@ -68,3 +220,15 @@ type VersionApiServicer interface {
 	GetOpenAPI(context.Context) (ImplResponse, error)
 	GetVersion(context.Context) (ImplResponse, error)
 }
+
+// ZonesApiServicer defines the api actions for the ZonesApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type ZonesApiServicer interface {
+	DeleteZoneById(context.Context, int64) (ImplResponse, error)
+	GetZoneById(context.Context, int64) (ImplResponse, error)
+	GetZones(context.Context, int64) (ImplResponse, error)
+	PostZone(context.Context, Zone) (ImplResponse, error)
+	PutZoneById(context.Context, int64, Zone) (ImplResponse, error)
+}
@@ -0,0 +1,263 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// AnalyticsApiController binds http requests to an api service and writes the service results to the http response
+type AnalyticsApiController struct {
+	service      AnalyticsApiServicer
+	errorHandler ErrorHandler
+}
+
+// AnalyticsApiOption for how the controller is set up.
+type AnalyticsApiOption func(*AnalyticsApiController)
+
+// WithAnalyticsApiErrorHandler inject ErrorHandler into controller
+func WithAnalyticsApiErrorHandler(h ErrorHandler) AnalyticsApiOption {
+	return func(c *AnalyticsApiController) {
+		c.errorHandler = h
+	}
+}
+
+// NewAnalyticsApiController creates a default api controller
+func NewAnalyticsApiController(s AnalyticsApiServicer, opts ...AnalyticsApiOption) Router {
+	controller := &AnalyticsApiController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the AnalyticsApiController
+func (c *AnalyticsApiController) Routes() Routes {
+	return Routes{
+		{
+			"GetDeviceDwellTimes",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/dwell-times/devices",
+			c.GetDeviceDwellTimes,
+		},
+		{
+			"GetHeatmap",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/floors/{floor-id}/heatmap",
+			c.GetHeatmap,
+		},
+		{
+			"GetHeatmapImage",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/floors/{floor-id}/heatmap/image",
+			c.GetHeatmapImage,
+		},
+		{
+			"GetRoomDwellTimes",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/dwell-times/rooms",
+			c.GetRoomDwellTimes,
+		},
+		{
+			"GetTrajectory",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/devices/{device-id}/trajectory",
+			c.GetTrajectory,
+		},
+		{
+			"GetTrajectoryGeoJson",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/devices/{device-id}/trajectory/geojson",
+			c.GetTrajectoryGeoJson,
+		},
+	}
+}
+
+// GetDeviceDwellTimes - Get dwell time per device
+func (c *AnalyticsApiController) GetDeviceDwellTimes(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	fromParam := query.Get("from")
+	toParam := query.Get("to")
+	result, err := c.service.GetDeviceDwellTimes(r.Context(), configIdParam, fromParam, toParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetHeatmap - Get position heatmap of a floor
+func (c *AnalyticsApiController) GetHeatmap(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	floorIdParam, err := parseInt32Parameter(params["floor-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	fromParam := query.Get("from")
+	toParam := query.Get("to")
+	cellSizeParam, err := parseFloat64Parameter(query.Get("cellSize"), false)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetHeatmap(r.Context(), configIdParam, floorIdParam, fromParam, toParam, cellSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetHeatmapImage - Get position heatmap of a floor as image
+func (c *AnalyticsApiController) GetHeatmapImage(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	floorIdParam, err := parseInt32Parameter(params["floor-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	fromParam := query.Get("from")
+	toParam := query.Get("to")
+	cellSizeParam, err := parseFloat64Parameter(query.Get("cellSize"), false)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetHeatmapImage(r.Context(), configIdParam, floorIdParam, fromParam, toParam, cellSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetRoomDwellTimes - Get dwell time per room
+func (c *AnalyticsApiController) GetRoomDwellTimes(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	fromParam := query.Get("from")
+	toParam := query.Get("to")
+	result, err := c.service.GetRoomDwellTimes(r.Context(), configIdParam, fromParam, toParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetTrajectory - Get trajectory of a device
+func (c *AnalyticsApiController) GetTrajectory(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	deviceIdParam := params["device-id"]
+
+	fromParam := query.Get("from")
+	toParam := query.Get("to")
+	maxPointsParam, err := parseInt32Parameter(query.Get("maxPoints"), false)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetTrajectory(r.Context(), configIdParam, deviceIdParam, fromParam, toParam, maxPointsParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetTrajectoryGeoJson - Get trajectory of a device as GeoJSON
+func (c *AnalyticsApiController) GetTrajectoryGeoJson(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	deviceIdParam := params["device-id"]
+
+	fromParam := query.Get("from")
+	toParam := query.Get("to")
+	maxPointsParam, err := parseInt32Parameter(query.Get("maxPoints"), false)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetTrajectoryGeoJson(r.Context(), configIdParam, deviceIdParam, fromParam, toParam, maxPointsParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
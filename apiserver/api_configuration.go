@@ -11,6 +11,8 @@ package apiserver
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"strings"
 
@@ -74,12 +76,36 @@ func (c *ConfigurationApiController) Routes() Routes {
 			"/v1/configs",
 			c.PostConfiguration,
 		},
+		{
+			"PreviewAssetFilter",
+			strings.ToUpper("Post"),
+			"/v1/configs/asset-filter-preview",
+			c.PreviewAssetFilter,
+		},
+		{
+			"PreviewAssetFilterById",
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/asset-filter-preview",
+			c.PreviewAssetFilterById,
+		},
 		{
 			"PutConfigurationById",
 			strings.ToUpper("Put"),
 			"/v1/configs/{config-id}",
 			c.PutConfigurationById,
 		},
+		{
+			"TestConnection",
+			strings.ToUpper("Post"),
+			"/v1/configs/test",
+			c.TestConnection,
+		},
+		{
+			"TestConnectionById",
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/test",
+			c.TestConnectionById,
+		},
 	}
 }
 
@@ -160,6 +186,64 @@ func (c *ConfigurationApiController) PostConfiguration(w http.ResponseWriter, r
 
 }
 
+// PreviewAssetFilter - Preview the asset filter of a draft configuration
+func (c *ConfigurationApiController) PreviewAssetFilter(w http.ResponseWriter, r *http.Request) {
+	configurationParam := Configuration{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&configurationParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertConfigurationRequired(configurationParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PreviewAssetFilter(r.Context(), configurationParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// PreviewAssetFilterById - Preview the asset filter of a configuration
+func (c *ConfigurationApiController) PreviewAssetFilterById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	// The body is optional, without it the asset filter of the configuration is previewed.
+	var assetFilterParam *[][]FilterRule
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&assetFilterParam); err != nil && !errors.Is(err, io.EOF) {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if assetFilterParam != nil {
+		if err := AssertRecurseFilterRuleRequired(*assetFilterParam); err != nil {
+			c.errorHandler(w, r, err, nil)
+			return
+		}
+	}
+	result, err := c.service.PreviewAssetFilterById(r.Context(), configIdParam, assetFilterParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // PutConfigurationById - Updates a configuration
 func (c *ConfigurationApiController) PutConfigurationById(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -190,3 +274,47 @@ func (c *ConfigurationApiController) PutConfigurationById(w http.ResponseWriter,
 	EncodeJSONResponse(result.Body, &result.Code, w)
 
 }
+
+// TestConnection - Test the connection of a draft configuration
+func (c *ConfigurationApiController) TestConnection(w http.ResponseWriter, r *http.Request) {
+	configurationParam := Configuration{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&configurationParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertConfigurationRequired(configurationParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.TestConnection(r.Context(), configurationParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// TestConnectionById - Test the connection of a configuration
+func (c *ConfigurationApiController) TestConnectionById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.TestConnectionById(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
@@ -0,0 +1,116 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// FloorHeightsApiController binds http requests to an api service and writes the service results to the http response
+type FloorHeightsApiController struct {
+	service      FloorHeightsApiServicer
+	errorHandler ErrorHandler
+}
+
+// FloorHeightsApiOption for how the controller is set up.
+type FloorHeightsApiOption func(*FloorHeightsApiController)
+
+// WithFloorHeightsApiErrorHandler inject ErrorHandler into controller
+func WithFloorHeightsApiErrorHandler(h ErrorHandler) FloorHeightsApiOption {
+	return func(c *FloorHeightsApiController) {
+		c.errorHandler = h
+	}
+}
+
+// NewFloorHeightsApiController creates a default api controller
+func NewFloorHeightsApiController(s FloorHeightsApiServicer, opts ...FloorHeightsApiOption) Router {
+	controller := &FloorHeightsApiController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the FloorHeightsApiController
+func (c *FloorHeightsApiController) Routes() Routes {
+	return Routes{
+		{
+			"GetFloorHeights",
+			strings.ToUpper("Get"),
+			"/v1/floor-heights",
+			c.GetFloorHeights,
+		},
+		{
+			"PutFloorHeight",
+			strings.ToUpper("Put"),
+			"/v1/floor-heights/{asset-id}",
+			c.PutFloorHeight,
+		},
+	}
+}
+
+// GetFloorHeights - Get floor heights
+func (c *FloorHeightsApiController) GetFloorHeights(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	configIdParam, err := parseInt64Parameter(query.Get("configId"), false)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetFloorHeights(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// PutFloorHeight - Sets the height of a floor
+func (c *FloorHeightsApiController) PutFloorHeight(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	assetIdParam, err := parseInt32Parameter(params["asset-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	floorHeightParam := FloorHeight{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&floorHeightParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertFloorHeightRequired(floorHeightParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PutFloorHeight(r.Context(), assetIdParam, floorHeightParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
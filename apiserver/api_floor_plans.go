@@ -0,0 +1,143 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// FloorPlansApiController binds http requests to an api service and writes the service results to the http response
+type FloorPlansApiController struct {
+	service      FloorPlansApiServicer
+	errorHandler ErrorHandler
+}
+
+// FloorPlansApiOption for how the controller is set up.
+type FloorPlansApiOption func(*FloorPlansApiController)
+
+// WithFloorPlansApiErrorHandler inject ErrorHandler into controller
+func WithFloorPlansApiErrorHandler(h ErrorHandler) FloorPlansApiOption {
+	return func(c *FloorPlansApiController) {
+		c.errorHandler = h
+	}
+}
+
+// NewFloorPlansApiController creates a default api controller
+func NewFloorPlansApiController(s FloorPlansApiServicer, opts ...FloorPlansApiOption) Router {
+	controller := &FloorPlansApiController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the FloorPlansApiController
+func (c *FloorPlansApiController) Routes() Routes {
+	return Routes{
+		{
+			"GetFloorPlan",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/floor-plans/{floor-id}",
+			c.GetFloorPlan,
+		},
+		{
+			"GetFloorPlanImage",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/floor-plans/{floor-id}/image",
+			c.GetFloorPlanImage,
+		},
+		{
+			"GetFloorPlans",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/floor-plans",
+			c.GetFloorPlans,
+		},
+	}
+}
+
+// GetFloorPlan - Get floor plan
+func (c *FloorPlansApiController) GetFloorPlan(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	floorIdParam, err := parseInt32Parameter(params["floor-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.GetFloorPlan(r.Context(), configIdParam, floorIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetFloorPlanImage - Get floor plan image
+func (c *FloorPlansApiController) GetFloorPlanImage(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	floorIdParam, err := parseInt32Parameter(params["floor-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.GetFloorPlanImage(r.Context(), configIdParam, floorIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetFloorPlans - Get floor plans
+func (c *FloorPlansApiController) GetFloorPlans(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.GetFloorPlans(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
@@ -0,0 +1,105 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// InventoryApiController binds http requests to an api service and writes the service results to the http response
+type InventoryApiController struct {
+	service      InventoryApiServicer
+	errorHandler ErrorHandler
+}
+
+// InventoryApiOption for how the controller is set up.
+type InventoryApiOption func(*InventoryApiController)
+
+// WithInventoryApiErrorHandler inject ErrorHandler into controller
+func WithInventoryApiErrorHandler(h ErrorHandler) InventoryApiOption {
+	return func(c *InventoryApiController) {
+		c.errorHandler = h
+	}
+}
+
+// NewInventoryApiController creates a default api controller
+func NewInventoryApiController(s InventoryApiServicer, opts ...InventoryApiOption) Router {
+	controller := &InventoryApiController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the InventoryApiController
+func (c *InventoryApiController) Routes() Routes {
+	return Routes{
+		{
+			"GetInventoryDevices",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/devices",
+			c.GetInventoryDevices,
+		},
+		{
+			"GetInventoryLocations",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/locations",
+			c.GetInventoryLocations,
+		},
+	}
+}
+
+// GetInventoryDevices - Get devices known to the app
+func (c *InventoryApiController) GetInventoryDevices(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.GetInventoryDevices(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetInventoryLocations - Get locations known to the app
+func (c *InventoryApiController) GetInventoryLocations(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.GetInventoryLocations(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
@@ -0,0 +1,111 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// MaintenanceApiController binds http requests to an api service and writes the service results to the http response
+type MaintenanceApiController struct {
+	service      MaintenanceApiServicer
+	errorHandler ErrorHandler
+}
+
+// MaintenanceApiOption for how the controller is set up.
+type MaintenanceApiOption func(*MaintenanceApiController)
+
+// WithMaintenanceApiErrorHandler inject ErrorHandler into controller
+func WithMaintenanceApiErrorHandler(h ErrorHandler) MaintenanceApiOption {
+	return func(c *MaintenanceApiController) {
+		c.errorHandler = h
+	}
+}
+
+// NewMaintenanceApiController creates a default api controller
+func NewMaintenanceApiController(s MaintenanceApiServicer, opts ...MaintenanceApiOption) Router {
+	controller := &MaintenanceApiController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the MaintenanceApiController
+func (c *MaintenanceApiController) Routes() Routes {
+	return Routes{
+		{
+			"GetBatteryForecasts",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/battery-forecasts",
+			c.GetBatteryForecasts,
+		},
+		{
+			"GetFirmwareInventory",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/firmware",
+			c.GetFirmwareInventory,
+		},
+	}
+}
+
+// GetBatteryForecasts - Get devices due for battery replacement
+func (c *MaintenanceApiController) GetBatteryForecasts(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	withinDaysParam, err := parseInt32Parameter(query.Get("withinDays"), false)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetBatteryForecasts(r.Context(), configIdParam, withinDaysParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetFirmwareInventory - Get firmware versions of the devices
+func (c *MaintenanceApiController) GetFirmwareInventory(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.GetFirmwareInventory(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
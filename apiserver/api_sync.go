@@ -0,0 +1,171 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// SyncApiController binds http requests to an api service and writes the service results to the http response
+type SyncApiController struct {
+	service      SyncApiServicer
+	errorHandler ErrorHandler
+}
+
+// SyncApiOption for how the controller is set up.
+type SyncApiOption func(*SyncApiController)
+
+// WithSyncApiErrorHandler inject ErrorHandler into controller
+func WithSyncApiErrorHandler(h ErrorHandler) SyncApiOption {
+	return func(c *SyncApiController) {
+		c.errorHandler = h
+	}
+}
+
+// NewSyncApiController creates a default api controller
+func NewSyncApiController(s SyncApiServicer, opts ...SyncApiOption) Router {
+	controller := &SyncApiController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the SyncApiController
+func (c *SyncApiController) Routes() Routes {
+	return Routes{
+		{
+			"GetSyncRun",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/runs/{run-id}",
+			c.GetSyncRun,
+		},
+		{
+			"GetSyncRuns",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/runs",
+			c.GetSyncRuns,
+		},
+		{
+			"GetSyncStatus",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/status",
+			c.GetSyncStatus,
+		},
+		{
+			"TriggerSync",
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/sync",
+			c.TriggerSync,
+		},
+	}
+}
+
+// GetSyncRun - Get a collection run of a configuration
+func (c *SyncApiController) GetSyncRun(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	runIdParam, err := parseInt64Parameter(params["run-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.GetSyncRun(r.Context(), configIdParam, runIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetSyncRuns - Get the collection runs of a configuration
+func (c *SyncApiController) GetSyncRuns(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	limitParam, err := parseInt32Parameter(query.Get("limit"), false)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetSyncRuns(r.Context(), configIdParam, limitParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetSyncStatus - Get the synchronization status of a configuration
+func (c *SyncApiController) GetSyncStatus(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.GetSyncStatus(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// TriggerSync - Queue an immediate collection run of a configuration
+func (c *SyncApiController) TriggerSync(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	scopeParam := query.Get("scope")
+	result, err := c.service.TriggerSync(r.Context(), configIdParam, scopeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
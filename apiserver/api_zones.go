@@ -0,0 +1,198 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// ZonesApiController binds http requests to an api service and writes the service results to the http response
+type ZonesApiController struct {
+	service      ZonesApiServicer
+	errorHandler ErrorHandler
+}
+
+// ZonesApiOption for how the controller is set up.
+type ZonesApiOption func(*ZonesApiController)
+
+// WithZonesApiErrorHandler inject ErrorHandler into controller
+func WithZonesApiErrorHandler(h ErrorHandler) ZonesApiOption {
+	return func(c *ZonesApiController) {
+		c.errorHandler = h
+	}
+}
+
+// NewZonesApiController creates a default api controller
+func NewZonesApiController(s ZonesApiServicer, opts ...ZonesApiOption) Router {
+	controller := &ZonesApiController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the ZonesApiController
+func (c *ZonesApiController) Routes() Routes {
+	return Routes{
+		{
+			"DeleteZoneById",
+			strings.ToUpper("Delete"),
+			"/v1/zones/{zone-id}",
+			c.DeleteZoneById,
+		},
+		{
+			"GetZoneById",
+			strings.ToUpper("Get"),
+			"/v1/zones/{zone-id}",
+			c.GetZoneById,
+		},
+		{
+			"GetZones",
+			strings.ToUpper("Get"),
+			"/v1/zones",
+			c.GetZones,
+		},
+		{
+			"PostZone",
+			strings.ToUpper("Post"),
+			"/v1/zones",
+			c.PostZone,
+		},
+		{
+			"PutZoneById",
+			strings.ToUpper("Put"),
+			"/v1/zones/{zone-id}",
+			c.PutZoneById,
+		},
+	}
+}
+
+// DeleteZoneById - Deletes a zone
+func (c *ZonesApiController) DeleteZoneById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	zoneIdParam, err := parseInt64Parameter(params["zone-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.DeleteZoneById(r.Context(), zoneIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetZoneById - Get zone
+func (c *ZonesApiController) GetZoneById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	zoneIdParam, err := parseInt64Parameter(params["zone-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.GetZoneById(r.Context(), zoneIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetZones - Get all zones
+func (c *ZonesApiController) GetZones(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	configIdParam, err := parseInt64Parameter(query.Get("configId"), false)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetZones(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// PostZone - Creates a zone
+func (c *ZonesApiController) PostZone(w http.ResponseWriter, r *http.Request) {
+	zoneParam := Zone{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&zoneParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertZoneRequired(zoneParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PostZone(r.Context(), zoneParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// PutZoneById - Updates a zone
+func (c *ZonesApiController) PutZoneById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	zoneIdParam, err := parseInt64Parameter(params["zone-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	zoneParam := Zone{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&zoneParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertZoneRequired(zoneParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PutZoneById(r.Context(), zoneIdParam, zoneParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
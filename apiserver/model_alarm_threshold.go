@@ -0,0 +1,52 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// AlarmThreshold - Thresholds of the alarm rule created for an attribute of new device assets
+type AlarmThreshold struct {
+
+	// Asset type the thresholds apply to. If empty, they apply to all device asset types.
+	AssetType string `json:"assetType,omitempty"`
+
+	// Attribute of the device asset, e.g. battery_level, temperature, humidity, air_quality or online
+	Attribute string `json:"attribute"`
+
+	// Triggers an alarm if the value is less than this value
+	Low *float64 `json:"low,omitempty"`
+
+	// Triggers an alarm if the value is greater than this value
+	High *float64 `json:"high,omitempty"`
+}
+
+// AssertAlarmThresholdRequired checks if the required fields are not zero-ed
+func AssertAlarmThresholdRequired(obj AlarmThreshold) error {
+	elements := map[string]interface{}{
+		"attribute": obj.Attribute,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertRecurseAlarmThresholdRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of AlarmThreshold (e.g. [][]AlarmThreshold), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseAlarmThresholdRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aAlarmThreshold, ok := obj.(AlarmThreshold)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertAlarmThresholdRequired(aAlarmThreshold)
+	})
+}
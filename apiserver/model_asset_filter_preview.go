@@ -0,0 +1,60 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// AssetFilterPreview - Result of applying an asset filter to a Kontakt.io device
+type AssetFilterPreview struct {
+
+	// Kontakt.io device ID (MAC address)
+	DeviceId string `json:"deviceId,omitempty"`
+
+	// Device name
+	Name string `json:"name,omitempty"`
+
+	// Kontakt.io product name
+	Product string `json:"product,omitempty"`
+
+	// Eliona asset type of the device. Empty for products without assets, which are never created.
+	AssetType string `json:"assetType,omitempty"`
+
+	// Device properties the filter rules are applied to
+	Properties map[string]string `json:"properties,omitempty"`
+
+	// Whether the device passes the filter and an asset would be created for it
+	Included bool `json:"included"`
+
+	// Index of the first rule set matched by all properties
+	MatchedRuleSet *int32 `json:"matchedRuleSet,omitempty"`
+
+	// Rules not matched by the device properties
+	MismatchedRules []FilterRule `json:"mismatchedRules,omitempty"`
+}
+
+// AssertAssetFilterPreviewRequired checks if the required fields are not zero-ed
+func AssertAssetFilterPreviewRequired(obj AssetFilterPreview) error {
+	for _, el := range obj.MismatchedRules {
+		if err := AssertFilterRuleRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertRecurseAssetFilterPreviewRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of AssetFilterPreview (e.g. [][]AssetFilterPreview), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseAssetFilterPreviewRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aAssetFilterPreview, ok := obj.(AssetFilterPreview)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertAssetFilterPreviewRequired(aAssetFilterPreview)
+	})
+}
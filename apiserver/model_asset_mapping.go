@@ -0,0 +1,37 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// AssetMapping - Eliona asset created for a Kontakt.io device or location in a project
+type AssetMapping struct {
+
+	// Eliona project ID
+	ProjectId string `json:"projectId,omitempty"`
+
+	// Eliona asset ID
+	AssetId int32 `json:"assetId,omitempty"`
+}
+
+// AssertAssetMappingRequired checks if the required fields are not zero-ed
+func AssertAssetMappingRequired(obj AssetMapping) error {
+	return nil
+}
+
+// AssertRecurseAssetMappingRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of AssetMapping (e.g. [][]AssetMapping), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseAssetMappingRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aAssetMapping, ok := obj.(AssetMapping)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertAssetMappingRequired(aAssetMapping)
+	})
+}
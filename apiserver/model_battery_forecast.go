@@ -0,0 +1,50 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// BatteryForecast - Forecast of the battery depletion of a device, estimated from its battery level history
+type BatteryForecast struct {
+
+	// Kontakt.io device ID
+	DeviceId string `json:"deviceId,omitempty"`
+
+	// Median battery level in percent on the last day with recorded levels
+	BatteryLevel float64 `json:"batteryLevel,omitempty"`
+
+	// Estimated discharge in percentage points per day
+	DischargeRate float64 `json:"dischargeRate,omitempty"`
+
+	// Estimated time the battery is depleted
+	DepletionDate time.Time `json:"depletionDate,omitempty"`
+
+	// Days until the battery is depleted, 0 if the estimated depletion is in the past
+	DaysLeft float64 `json:"daysLeft,omitempty"`
+}
+
+// AssertBatteryForecastRequired checks if the required fields are not zero-ed
+func AssertBatteryForecastRequired(obj BatteryForecast) error {
+	return nil
+}
+
+// AssertRecurseBatteryForecastRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of BatteryForecast (e.g. [][]BatteryForecast), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseBatteryForecastRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aBatteryForecast, ok := obj.(BatteryForecast)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertBatteryForecastRequired(aBatteryForecast)
+	})
+}
@@ -30,17 +30,32 @@ type Configuration struct {
 	// Array of rules combined by logical OR
 	AssetFilter [][]FilterRule `json:"assetFilter,omitempty"`
 
+	// Array of rules combined by logical OR, selecting the buildings, floors and rooms to import
+	LocationFilter [][]FilterRule `json:"locationFilter,omitempty"`
+
 	// Set to `true` by the app when running and to `false` when app is stopped
 	Active *bool `json:"active,omitempty"`
 
 	// List of Eliona project ids for which this device should collect data. For each project id all smart devices are automatically created as an asset in Eliona. The mapping between Eliona is stored as an asset mapping in the Kontakt.io app.
 	ProjectIDs *[]string `json:"projectIDs,omitempty"`
 
+	// Rules assigning locations and devices to specific projects. Projects without a mapping receive all locations and devices.
+	ProjectMappings []ProjectMapping `json:"projectMappings,omitempty"`
+
 	// Position of Kontakt.io origin on Eliona coordinate system - X axis
 	AbsoluteX float64 `json:"absoluteX,omitempty"`
 
 	// Position of Kontakt.io origin on Eliona coordinate system - Y axis
 	AbsoluteY float64 `json:"absoluteY,omitempty"`
+
+	// Number of days the position history of tracked devices is kept
+	PositionRetention *int32 `json:"positionRetention,omitempty"`
+
+	// Thresholds of the alarm rules created for new device assets, overriding the defaults
+	AlarmThresholds []AlarmThreshold `json:"alarmThresholds,omitempty"`
+
+	// Minimum firmware version per Kontakt.io product. Devices with an older firmware are flagged as outdated.
+	FirmwareVersions map[string]string `json:"firmwareVersions,omitempty"`
 }
 
 // AssertConfigurationRequired checks if the required fields are not zero-ed
@@ -48,6 +63,19 @@ func AssertConfigurationRequired(obj Configuration) error {
 	if err := AssertRecurseFilterRuleRequired(obj.AssetFilter); err != nil {
 		return err
 	}
+	if err := AssertRecurseFilterRuleRequired(obj.LocationFilter); err != nil {
+		return err
+	}
+	for _, el := range obj.ProjectMappings {
+		if err := AssertProjectMappingRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.AlarmThresholds {
+		if err := AssertAlarmThresholdRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,52 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ConnectionTestResult - Result of requesting a Kontakt.io endpoint with the API key of a configuration
+type ConnectionTestResult struct {
+
+	// Tested Kontakt.io endpoint
+	Endpoint string `json:"endpoint"`
+
+	// Whether the request succeeded
+	Success bool `json:"success"`
+
+	// HTTP status of the response. Empty if no response was received.
+	StatusCode int32 `json:"statusCode,omitempty"`
+
+	// Latency of the request in seconds
+	LatencySeconds float64 `json:"latencySeconds"`
+
+	// Number of entries returned by the endpoint
+	Count int32 `json:"count"`
+
+	// Whether the API key is invalid or lacks the permission for the endpoint
+	PermissionDenied bool `json:"permissionDenied"`
+
+	// Reason the request failed
+	Error string `json:"error,omitempty"`
+}
+
+// AssertConnectionTestResultRequired checks if the required fields are not zero-ed
+func AssertConnectionTestResultRequired(obj ConnectionTestResult) error {
+	return nil
+}
+
+// AssertRecurseConnectionTestResultRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of ConnectionTestResult (e.g. [][]ConnectionTestResult), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseConnectionTestResultRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aConnectionTestResult, ok := obj.(ConnectionTestResult)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertConnectionTestResultRequired(aConnectionTestResult)
+	})
+}
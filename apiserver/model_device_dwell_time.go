@@ -0,0 +1,40 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// DeviceDwellTime - Time a tracked device spent in rooms
+type DeviceDwellTime struct {
+
+	// Kontakt.io device ID (MAC address)
+	DeviceId string `json:"deviceId,omitempty"`
+
+	// Number of room visits overlapping the time range
+	Visits int64 `json:"visits,omitempty"`
+
+	// Total time in seconds spent in rooms within the time range
+	DwellTime int64 `json:"dwellTime,omitempty"`
+}
+
+// AssertDeviceDwellTimeRequired checks if the required fields are not zero-ed
+func AssertDeviceDwellTimeRequired(obj DeviceDwellTime) error {
+	return nil
+}
+
+// AssertRecurseDeviceDwellTimeRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of DeviceDwellTime (e.g. [][]DeviceDwellTime), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseDeviceDwellTimeRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aDeviceDwellTime, ok := obj.(DeviceDwellTime)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertDeviceDwellTimeRequired(aDeviceDwellTime)
+	})
+}
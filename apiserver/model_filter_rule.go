@@ -14,6 +14,15 @@ type FilterRule struct {
 	Parameter string `json:"parameter,omitempty"`
 
 	Regex string `json:"regex,omitempty"`
+
+	// Comparison of the parameter. Defaults to regex, which matches the regular expression.
+	Operator string `json:"operator,omitempty"`
+
+	// Value compared with the parameter by the eq, ne, lt, le, gt and ge operators
+	Value string `json:"value,omitempty"`
+
+	// Inverts the rule, so that it matches if the comparison fails or the parameter is missing
+	Negate bool `json:"negate,omitempty"`
 }
 
 // AssertFilterRuleRequired checks if the required fields are not zero-ed
@@ -0,0 +1,51 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// FirmwareProduct - Firmware versions of the devices of a Kontakt.io product
+type FirmwareProduct struct {
+
+	// Kontakt.io product name
+	Product string `json:"product,omitempty"`
+
+	// Firmware version the devices should have, either configured or the latest known version
+	TargetVersion string `json:"targetVersion,omitempty"`
+
+	// Number of devices of the product
+	DeviceCount int32 `json:"deviceCount,omitempty"`
+
+	// Number of devices with an outdated firmware
+	OutdatedCount int32 `json:"outdatedCount,omitempty"`
+
+	// Firmware versions of the devices, latest first
+	Versions []FirmwareVersion `json:"versions,omitempty"`
+}
+
+// AssertFirmwareProductRequired checks if the required fields are not zero-ed
+func AssertFirmwareProductRequired(obj FirmwareProduct) error {
+	for _, el := range obj.Versions {
+		if err := AssertFirmwareVersionRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertRecurseFirmwareProductRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of FirmwareProduct (e.g. [][]FirmwareProduct), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseFirmwareProductRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aFirmwareProduct, ok := obj.(FirmwareProduct)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertFirmwareProductRequired(aFirmwareProduct)
+	})
+}
@@ -0,0 +1,40 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// FirmwareVersion - Devices of a product running the same firmware version
+type FirmwareVersion struct {
+
+	// Firmware version
+	Firmware string `json:"firmware,omitempty"`
+
+	// Whether the version is older than the target version of the product
+	Outdated bool `json:"outdated"`
+
+	// Kontakt.io IDs of the devices running the version
+	DeviceIds []string `json:"deviceIds,omitempty"`
+}
+
+// AssertFirmwareVersionRequired checks if the required fields are not zero-ed
+func AssertFirmwareVersionRequired(obj FirmwareVersion) error {
+	return nil
+}
+
+// AssertRecurseFirmwareVersionRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of FirmwareVersion (e.g. [][]FirmwareVersion), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseFirmwareVersionRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aFirmwareVersion, ok := obj.(FirmwareVersion)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertFirmwareVersionRequired(aFirmwareVersion)
+	})
+}
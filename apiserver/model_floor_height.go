@@ -0,0 +1,46 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// FloorHeight - Height of a floor location, used as Z coordinate of the device positions on the floor
+type FloorHeight struct {
+
+	// Eliona asset ID of the floor
+	AssetId int32 `json:"assetId,omitempty"`
+
+	// Configuration the floor belongs to
+	ConfigurationId int64 `json:"configurationId,omitempty"`
+
+	// Eliona project ID of the floor asset
+	ProjectId string `json:"projectId,omitempty"`
+
+	// Kontakt.io floor ID
+	FloorId int32 `json:"floorId,omitempty"`
+
+	// Height of the floor above ground in meters
+	Height *float64 `json:"height,omitempty"`
+}
+
+// AssertFloorHeightRequired checks if the required fields are not zero-ed
+func AssertFloorHeightRequired(obj FloorHeight) error {
+	return nil
+}
+
+// AssertRecurseFloorHeightRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of FloorHeight (e.g. [][]FloorHeight), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseFloorHeightRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aFloorHeight, ok := obj.(FloorHeight)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertFloorHeightRequired(aFloorHeight)
+	})
+}
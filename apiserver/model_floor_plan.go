@@ -0,0 +1,62 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// FloorPlan - Plan of a floor imported from Kontakt.io and its placement in Kontakt.io floor coordinates
+type FloorPlan struct {
+
+	// Kontakt.io floor ID
+	FloorId int32 `json:"floorId,omitempty"`
+
+	// Whether a plan image is available at the image endpoint
+	HasImage bool `json:"hasImage,omitempty"`
+
+	// Width of the plan image in pixels
+	ImageWidth int32 `json:"imageWidth,omitempty"`
+
+	// Height of the plan image in pixels
+	ImageHeight int32 `json:"imageHeight,omitempty"`
+
+	// Scale of the plan image in pixels per meter
+	Scale float64 `json:"scale,omitempty"`
+
+	// X coordinate of the Kontakt.io floor origin on the plan image in pixels
+	OriginX float64 `json:"originX,omitempty"`
+
+	// Y coordinate of the Kontakt.io floor origin on the plan image in pixels
+	OriginY float64 `json:"originY,omitempty"`
+
+	// Rotation of the Kontakt.io floor coordinates against the plan image in degrees
+	Rotation float64 `json:"rotation,omitempty"`
+
+	// Time the floor plan was last imported
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// AssertFloorPlanRequired checks if the required fields are not zero-ed
+func AssertFloorPlanRequired(obj FloorPlan) error {
+	return nil
+}
+
+// AssertRecurseFloorPlanRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of FloorPlan (e.g. [][]FloorPlan), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseFloorPlanRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aFloorPlan, ok := obj.(FloorPlan)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertFloorPlanRequired(aFloorPlan)
+	})
+}
@@ -0,0 +1,49 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// HealthCheck - Result of a single health check
+type HealthCheck struct {
+
+	// Name of the check
+	Name string `json:"name"`
+
+	// Whether the check passed
+	Healthy bool `json:"healthy"`
+
+	// Reason the check failed
+	Error string `json:"error,omitempty"`
+}
+
+// AssertHealthCheckRequired checks if the required fields are not zero-ed
+func AssertHealthCheckRequired(obj HealthCheck) error {
+	elements := map[string]interface{}{
+		"name": obj.Name,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertRecurseHealthCheckRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of HealthCheck (e.g. [][]HealthCheck), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseHealthCheckRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aHealthCheck, ok := obj.(HealthCheck)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertHealthCheckRequired(aHealthCheck)
+	})
+}
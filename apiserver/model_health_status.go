@@ -0,0 +1,41 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// HealthStatus - Overall health of the app with the results of the single checks
+type HealthStatus struct {
+
+	// Whether all checks passed
+	Healthy bool `json:"healthy"`
+
+	Checks []HealthCheck `json:"checks"`
+}
+
+// AssertHealthStatusRequired checks if the required fields are not zero-ed
+func AssertHealthStatusRequired(obj HealthStatus) error {
+	for _, el := range obj.Checks {
+		if err := AssertHealthCheckRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertRecurseHealthStatusRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of HealthStatus (e.g. [][]HealthStatus), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseHealthStatusRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aHealthStatus, ok := obj.(HealthStatus)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertHealthStatusRequired(aHealthStatus)
+	})
+}
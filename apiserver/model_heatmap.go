@@ -0,0 +1,55 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// Heatmap - Density of recorded positions on a floor, counted in a grid of square cells
+type Heatmap struct {
+
+	// Kontakt.io floor ID
+	FloorId int32 `json:"floorId,omitempty"`
+
+	// Edge length of a cell in meters
+	CellSize float64 `json:"cellSize,omitempty"`
+
+	// X coordinate of the grid corner in Kontakt.io floor coordinates
+	OriginX float64 `json:"originX,omitempty"`
+
+	// Y coordinate of the grid corner in Kontakt.io floor coordinates
+	OriginY float64 `json:"originY,omitempty"`
+
+	// Number of cells along the X axis
+	Columns int32 `json:"columns,omitempty"`
+
+	// Number of cells along the Y axis
+	Rows int32 `json:"rows,omitempty"`
+
+	// Highest number of positions in a single cell
+	MaxCount int64 `json:"maxCount,omitempty"`
+
+	// Number of positions per cell, one array per row starting at the grid corner
+	Cells [][]int64 `json:"cells,omitempty"`
+}
+
+// AssertHeatmapRequired checks if the required fields are not zero-ed
+func AssertHeatmapRequired(obj Heatmap) error {
+	return nil
+}
+
+// AssertRecurseHeatmapRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of Heatmap (e.g. [][]Heatmap), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseHeatmapRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aHeatmap, ok := obj.(Heatmap)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertHeatmapRequired(aHeatmap)
+	})
+}
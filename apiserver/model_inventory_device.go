@@ -0,0 +1,64 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// InventoryDevice - Kontakt.io device known to the app
+type InventoryDevice struct {
+
+	// Kontakt.io device ID (MAC address)
+	DeviceId string `json:"deviceId,omitempty"`
+
+	// Device name
+	Name string `json:"name,omitempty"`
+
+	// Kontakt.io product name
+	Product string `json:"product,omitempty"`
+
+	// Eliona asset type of the device. Empty for products without assets.
+	AssetType string `json:"assetType,omitempty"`
+
+	// Firmware version
+	Firmware string `json:"firmware,omitempty"`
+
+	// Whether the device passes the asset filter of the configuration
+	MatchesFilter bool `json:"matchesFilter"`
+
+	// Time the device last reported telemetry or a position
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty"`
+
+	// Eliona assets of the device, one per project
+	Assets []AssetMapping `json:"assets"`
+}
+
+// AssertInventoryDeviceRequired checks if the required fields are not zero-ed
+func AssertInventoryDeviceRequired(obj InventoryDevice) error {
+	for _, el := range obj.Assets {
+		if err := AssertAssetMappingRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertRecurseInventoryDeviceRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of InventoryDevice (e.g. [][]InventoryDevice), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseInventoryDeviceRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aInventoryDevice, ok := obj.(InventoryDevice)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertInventoryDeviceRequired(aInventoryDevice)
+	})
+}
@@ -0,0 +1,51 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// InventoryLocation - Kontakt.io location known to the app
+type InventoryLocation struct {
+
+	// Kontakt.io building, floor or room ID
+	LocationId int32 `json:"locationId,omitempty"`
+
+	// Eliona asset type of the location
+	AssetType string `json:"assetType,omitempty"`
+
+	// Room number used by the IR detection of beacons
+	RoomNumber *int32 `json:"roomNumber,omitempty"`
+
+	// Height of the floor above ground in meters
+	FloorHeight *float64 `json:"floorHeight,omitempty"`
+
+	// Eliona assets of the location, one per project
+	Assets []AssetMapping `json:"assets"`
+}
+
+// AssertInventoryLocationRequired checks if the required fields are not zero-ed
+func AssertInventoryLocationRequired(obj InventoryLocation) error {
+	for _, el := range obj.Assets {
+		if err := AssertAssetMappingRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertRecurseInventoryLocationRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of InventoryLocation (e.g. [][]InventoryLocation), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseInventoryLocationRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aInventoryLocation, ok := obj.(InventoryLocation)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertInventoryLocationRequired(aInventoryLocation)
+	})
+}
@@ -0,0 +1,37 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// LineString - GeoJSON LineString geometry
+type LineString struct {
+
+	// GeoJSON type, always `LineString`
+	Type string `json:"type,omitempty"`
+
+	// Positions as [x, y] pairs in Kontakt.io floor coordinates
+	Coordinates [][]float64 `json:"coordinates,omitempty"`
+}
+
+// AssertLineStringRequired checks if the required fields are not zero-ed
+func AssertLineStringRequired(obj LineString) error {
+	return nil
+}
+
+// AssertRecurseLineStringRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of LineString (e.g. [][]LineString), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseLineStringRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aLineString, ok := obj.(LineString)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertLineStringRequired(aLineString)
+	})
+}
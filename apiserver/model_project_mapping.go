@@ -0,0 +1,55 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ProjectMapping - Rules selecting the locations and devices an Eliona project receives
+type ProjectMapping struct {
+
+	// Eliona project ID the rules apply to. Must be one of the project IDs of the configuration.
+	ProjectId string `json:"projectId"`
+
+	// Array of rules combined by logical OR
+	LocationFilter [][]FilterRule `json:"locationFilter,omitempty"`
+
+	// Array of rules combined by logical OR
+	AssetFilter [][]FilterRule `json:"assetFilter,omitempty"`
+}
+
+// AssertProjectMappingRequired checks if the required fields are not zero-ed
+func AssertProjectMappingRequired(obj ProjectMapping) error {
+	elements := map[string]interface{}{
+		"projectId": obj.ProjectId,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	if err := AssertRecurseFilterRuleRequired(obj.LocationFilter); err != nil {
+		return err
+	}
+	if err := AssertRecurseFilterRuleRequired(obj.AssetFilter); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AssertRecurseProjectMappingRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of ProjectMapping (e.g. [][]ProjectMapping), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseProjectMappingRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aProjectMapping, ok := obj.(ProjectMapping)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertProjectMappingRequired(aProjectMapping)
+	})
+}
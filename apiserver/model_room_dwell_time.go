@@ -0,0 +1,40 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// RoomDwellTime - Time tracked devices spent in a room
+type RoomDwellTime struct {
+
+	// Kontakt.io room ID
+	RoomId int32 `json:"roomId,omitempty"`
+
+	// Number of visits overlapping the time range
+	Visits int64 `json:"visits,omitempty"`
+
+	// Total time in seconds spent in the room within the time range
+	DwellTime int64 `json:"dwellTime,omitempty"`
+}
+
+// AssertRoomDwellTimeRequired checks if the required fields are not zero-ed
+func AssertRoomDwellTimeRequired(obj RoomDwellTime) error {
+	return nil
+}
+
+// AssertRecurseRoomDwellTimeRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of RoomDwellTime (e.g. [][]RoomDwellTime), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseRoomDwellTimeRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aRoomDwellTime, ok := obj.(RoomDwellTime)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertRoomDwellTimeRequired(aRoomDwellTime)
+	})
+}
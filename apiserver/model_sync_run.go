@@ -0,0 +1,71 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// SyncRun - Collection run of a configuration
+type SyncRun struct {
+
+	// Run ID
+	Id int64 `json:"id,omitempty"`
+
+	// Scope of the run: all, locations, devices or reconcile
+	Scope string `json:"scope,omitempty"`
+
+	// Status of the run: queued, running, succeeded or failed
+	Status string `json:"status,omitempty"`
+
+	// Time the run was requested. Empty for scheduled runs.
+	RequestedAt *time.Time `json:"requestedAt,omitempty"`
+
+	// Time the run started. Empty while queued.
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+
+	// Time the run finished. Empty while running.
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Duration of the run in seconds. Empty while running.
+	DurationSeconds *float64 `json:"durationSeconds,omitempty"`
+
+	// Number of devices fetched from Kontakt.io
+	DevicesFetched int32 `json:"devicesFetched"`
+
+	// Number of assets created in Eliona
+	AssetsCreated int32 `json:"assetsCreated"`
+
+	// Number of data points written to Eliona
+	DataPoints int32 `json:"dataPoints"`
+
+	// Error of the location phase, if any
+	LocationError string `json:"locationError,omitempty"`
+
+	// Error of the device phase, if any
+	DeviceError string `json:"deviceError,omitempty"`
+}
+
+// AssertSyncRunRequired checks if the required fields are not zero-ed
+func AssertSyncRunRequired(obj SyncRun) error {
+	return nil
+}
+
+// AssertRecurseSyncRunRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of SyncRun (e.g. [][]SyncRun), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseSyncRunRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aSyncRun, ok := obj.(SyncRun)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertSyncRunRequired(aSyncRun)
+	})
+}
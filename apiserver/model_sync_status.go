@@ -0,0 +1,60 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// SyncStatus - Current synchronization status of a configuration
+type SyncStatus struct {
+
+	// Configuration ID
+	ConfigId int64 `json:"configId,omitempty"`
+
+	// Whether the configuration is enabled
+	Enabled bool `json:"enabled"`
+
+	// Whether the app is collecting data for the configuration
+	Active bool `json:"active"`
+
+	// Whether the last run finished without errors and the configuration has no current error
+	Healthy bool `json:"healthy"`
+
+	// Current error of the configuration, either from its last run or from maintaining its state
+	Error string `json:"error,omitempty"`
+
+	// Start of the last run without errors
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty"`
+
+	LastRun *SyncRun `json:"lastRun,omitempty"`
+}
+
+// AssertSyncStatusRequired checks if the required fields are not zero-ed
+func AssertSyncStatusRequired(obj SyncStatus) error {
+	if obj.LastRun != nil {
+		if err := AssertSyncRunRequired(*obj.LastRun); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertRecurseSyncStatusRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of SyncStatus (e.g. [][]SyncStatus), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseSyncStatusRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aSyncStatus, ok := obj.(SyncStatus)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertSyncStatusRequired(aSyncStatus)
+	})
+}
@@ -0,0 +1,46 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// TrajectoryFeature - Trajectory of a tracked device as GeoJSON feature
+type TrajectoryFeature struct {
+
+	// GeoJSON type, always `Feature`
+	Type string `json:"type,omitempty"`
+
+	// Path of the device
+	Geometry LineString `json:"geometry,omitempty"`
+
+	// Device ID and the time of each position
+	Properties TrajectoryFeatureProperties `json:"properties,omitempty"`
+}
+
+// AssertTrajectoryFeatureRequired checks if the required fields are not zero-ed
+func AssertTrajectoryFeatureRequired(obj TrajectoryFeature) error {
+	if err := AssertLineStringRequired(obj.Geometry); err != nil {
+		return err
+	}
+	if err := AssertTrajectoryFeaturePropertiesRequired(obj.Properties); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AssertRecurseTrajectoryFeatureRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of TrajectoryFeature (e.g. [][]TrajectoryFeature), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseTrajectoryFeatureRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aTrajectoryFeature, ok := obj.(TrajectoryFeature)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertTrajectoryFeatureRequired(aTrajectoryFeature)
+	})
+}
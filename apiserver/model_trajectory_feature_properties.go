@@ -0,0 +1,41 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// TrajectoryFeatureProperties - Properties of a trajectory feature
+type TrajectoryFeatureProperties struct {
+
+	// Kontakt.io device ID (MAC address)
+	DeviceId string `json:"deviceId,omitempty"`
+
+	// Time of each coordinate of the line string
+	Timestamps []time.Time `json:"timestamps,omitempty"`
+}
+
+// AssertTrajectoryFeaturePropertiesRequired checks if the required fields are not zero-ed
+func AssertTrajectoryFeaturePropertiesRequired(obj TrajectoryFeatureProperties) error {
+	return nil
+}
+
+// AssertRecurseTrajectoryFeaturePropertiesRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of TrajectoryFeatureProperties (e.g. [][]TrajectoryFeatureProperties), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseTrajectoryFeaturePropertiesRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aTrajectoryFeatureProperties, ok := obj.(TrajectoryFeatureProperties)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertTrajectoryFeaturePropertiesRequired(aTrajectoryFeatureProperties)
+	})
+}
@@ -0,0 +1,50 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// TrajectoryPoint - Recorded position of a tracked device
+type TrajectoryPoint struct {
+
+	// Time the position was recorded by Kontakt.io
+	Timestamp time.Time `json:"timestamp,omitempty"`
+
+	// X coordinate in Kontakt.io floor coordinates
+	X float64 `json:"x,omitempty"`
+
+	// Y coordinate in Kontakt.io floor coordinates
+	Y float64 `json:"y,omitempty"`
+
+	// Kontakt.io floor ID
+	FloorId *int32 `json:"floorId,omitempty"`
+
+	// Kontakt.io room ID
+	RoomId *int32 `json:"roomId,omitempty"`
+}
+
+// AssertTrajectoryPointRequired checks if the required fields are not zero-ed
+func AssertTrajectoryPointRequired(obj TrajectoryPoint) error {
+	return nil
+}
+
+// AssertRecurseTrajectoryPointRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of TrajectoryPoint (e.g. [][]TrajectoryPoint), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseTrajectoryPointRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aTrajectoryPoint, ok := obj.(TrajectoryPoint)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertTrajectoryPointRequired(aTrajectoryPoint)
+	})
+}
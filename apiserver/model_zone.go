@@ -0,0 +1,61 @@
+/*
+ * Kontakt.io App API
+ *
+ * API to access and configure the Kontakt.io App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// Zone - A geofence zone, either a polygon on a floor or a set of rooms. Tracked devices entering or leaving the zone produce events on their assets.
+type Zone struct {
+
+	// Internal identifier for the zone (created automatically).
+	Id *int64 `json:"id,omitempty"`
+
+	// Configuration the zone belongs to
+	ConfigurationId int64 `json:"configurationId,omitempty"`
+
+	// Name of the zone, used in events
+	Name string `json:"name,omitempty"`
+
+	// Kontakt.io floor ID the polygon is placed on
+	FloorId *int32 `json:"floorId,omitempty"`
+
+	// Polygon vertices in Kontakt.io floor coordinates as [x, y] pairs
+	Polygon *[][]float64 `json:"polygon,omitempty"`
+
+	// Kontakt.io room IDs forming the zone
+	RoomIds *[]int32 `json:"roomIds,omitempty"`
+
+	// Time in seconds a device may stay in the zone before an event is raised
+	DwellThreshold *int32 `json:"dwellThreshold,omitempty"`
+
+	// Array of rules combined by logical OR
+	AllowedFilter [][]FilterRule `json:"allowedFilter,omitempty"`
+
+	// Raise an Eliona alarm on unauthorized entries and exceeded dwell time
+	RaiseAlarm bool `json:"raiseAlarm,omitempty"`
+}
+
+// AssertZoneRequired checks if the required fields are not zero-ed
+func AssertZoneRequired(obj Zone) error {
+	if err := AssertRecurseFilterRuleRequired(obj.AllowedFilter); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AssertRecurseZoneRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of Zone (e.g. [][]Zone), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseZoneRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aZone, ok := obj.(Zone)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertZoneRequired(aZone)
+	})
+}
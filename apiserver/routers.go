@@ -62,6 +62,17 @@ func NewRouter(routers ...Router) *mux.Router {
 
 // EncodeJSONResponse uses the json encoder to write an interface to the http response with an optional status code
 func EncodeJSONResponse(i interface{}, status *int, w http.ResponseWriter) error {
+	if data, ok := i.([]byte); ok {
+		w.Header().Set("Content-Type", http.DetectContentType(data))
+		if status != nil {
+			w.WriteHeader(*status)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		_, err := w.Write(data)
+		return err
+	}
+
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	if status != nil {
 		w.WriteHeader(*status)
@@ -141,6 +152,19 @@ func parseInt64Parameter(param string, required bool) (int64, error) {
 	return strconv.ParseInt(param, 10, 64)
 }
 
+// parseFloat64Parameter parses a string parameter to a float64.
+func parseFloat64Parameter(param string, required bool) (float64, error) {
+	if param == "" {
+		if required {
+			return 0, errors.New(errMsgRequiredMissing)
+		}
+
+		return 0, nil
+	}
+
+	return strconv.ParseFloat(param, 64)
+}
+
 // parseInt32Parameter parses a string parameter to an int32.
 func parseInt32Parameter(param string, required bool) (int32, error) {
 	if param == "" {
@@ -0,0 +1,225 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/conf"
+	"kontakt-io/tracking"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultHeatmapCellSize is the edge length of heatmap cells in meters if not requested otherwise.
+const defaultHeatmapCellSize = 1.0
+
+// AnalyticsApiService is a service that implements the logic for the AnalyticsApiServicer
+// This service should implement the business logic for every endpoint for the AnalyticsApi API.
+// Include any external packages or services that will be required by this service.
+type AnalyticsApiService struct {
+}
+
+// NewAnalyticsApiService creates a default api service
+func NewAnalyticsApiService() apiserver.AnalyticsApiServicer {
+	return &AnalyticsApiService{}
+}
+
+func (s *AnalyticsApiService) GetRoomDwellTimes(ctx context.Context, configId int64, from string, to string) (apiserver.ImplResponse, error) {
+	fromTime, toTime, err := parseTimeRange(from, to)
+	if err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	dwellTimes, err := conf.GetDwellTimes(ctx, configId, fromTime, toTime, false)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	rooms := make([]apiserver.RoomDwellTime, 0, len(dwellTimes))
+	for _, dwellTime := range dwellTimes {
+		roomId, err := strconv.ParseInt(dwellTime.Key, 10, 32)
+		if err != nil {
+			return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("parsing room ID %s: %v", dwellTime.Key, err)
+		}
+		rooms = append(rooms, apiserver.RoomDwellTime{
+			RoomId:    int32(roomId),
+			Visits:    dwellTime.Visits,
+			DwellTime: dwellTime.DwellSeconds,
+		})
+	}
+	return apiserver.Response(http.StatusOK, rooms), nil
+}
+
+func (s *AnalyticsApiService) GetDeviceDwellTimes(ctx context.Context, configId int64, from string, to string) (apiserver.ImplResponse, error) {
+	fromTime, toTime, err := parseTimeRange(from, to)
+	if err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	dwellTimes, err := conf.GetDwellTimes(ctx, configId, fromTime, toTime, true)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	devices := make([]apiserver.DeviceDwellTime, 0, len(dwellTimes))
+	for _, dwellTime := range dwellTimes {
+		devices = append(devices, apiserver.DeviceDwellTime{
+			DeviceId:  dwellTime.Key,
+			Visits:    dwellTime.Visits,
+			DwellTime: dwellTime.DwellSeconds,
+		})
+	}
+	return apiserver.Response(http.StatusOK, devices), nil
+}
+
+func (s *AnalyticsApiService) GetTrajectory(ctx context.Context, configId int64, deviceId string, from string, to string, maxPoints int32) (apiserver.ImplResponse, error) {
+	points, err := getTrajectory(ctx, configId, deviceId, from, to, maxPoints)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, points), nil
+}
+
+func (s *AnalyticsApiService) GetTrajectoryGeoJson(ctx context.Context, configId int64, deviceId string, from string, to string, maxPoints int32) (apiserver.ImplResponse, error) {
+	points, err := getTrajectory(ctx, configId, deviceId, from, to, maxPoints)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	feature := apiserver.TrajectoryFeature{
+		Type: "Feature",
+		Geometry: apiserver.LineString{
+			Type:        "LineString",
+			Coordinates: make([][]float64, 0, len(points)),
+		},
+		Properties: apiserver.TrajectoryFeatureProperties{
+			DeviceId:   deviceId,
+			Timestamps: make([]time.Time, 0, len(points)),
+		},
+	}
+	for _, point := range points {
+		feature.Geometry.Coordinates = append(feature.Geometry.Coordinates, []float64{point.X, point.Y})
+		feature.Properties.Timestamps = append(feature.Properties.Timestamps, point.Timestamp)
+	}
+	return apiserver.Response(http.StatusOK, feature), nil
+}
+
+func (s *AnalyticsApiService) GetHeatmap(ctx context.Context, configId int64, floorId int32, from string, to string, cellSize float64) (apiserver.ImplResponse, error) {
+	heatmap, err := getHeatmap(ctx, configId, floorId, from, to, cellSize)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, heatmap), nil
+}
+
+func (s *AnalyticsApiService) GetHeatmapImage(ctx context.Context, configId int64, floorId int32, from string, to string, cellSize float64) (apiserver.ImplResponse, error) {
+	heatmap, err := getHeatmap(ctx, configId, floorId, from, to, cellSize)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	floorPlan, err := conf.GetFloorPlan(ctx, configId, floorId)
+	if err != nil && !errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	image, err := tracking.RenderHeatmap(heatmap, floorPlan)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, image), nil
+}
+
+func getHeatmap(ctx context.Context, configId int64, floorId int32, from string, to string, cellSize float64) (apiserver.Heatmap, error) {
+	fromTime, toTime, err := parseTimeRange(from, to)
+	if err != nil {
+		return apiserver.Heatmap{}, fmt.Errorf("%w: %v", conf.ErrBadRequest, err)
+	}
+	if cellSize == 0 {
+		cellSize = defaultHeatmapCellSize
+	}
+	positions, err := conf.GetFloorPositions(ctx, configId, floorId, fromTime, toTime)
+	if err != nil {
+		return apiserver.Heatmap{}, fmt.Errorf("getting positions: %v", err)
+	}
+	// Validates the cell size, invalid sizes are bad requests.
+	return tracking.ComputeHeatmap(floorId, positions, cellSize)
+}
+
+func getTrajectory(ctx context.Context, configId int64, deviceId string, from string, to string, maxPoints int32) ([]apiserver.TrajectoryPoint, error) {
+	fromTime, toTime, err := parseTimeRange(from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", conf.ErrBadRequest, err)
+	}
+	if maxPoints != 0 && maxPoints < 2 {
+		return nil, fmt.Errorf("%w: 'maxPoints' must be at least 2", conf.ErrBadRequest)
+	}
+	points, err := conf.GetTrajectory(ctx, configId, deviceId, fromTime, toTime)
+	if err != nil {
+		return nil, fmt.Errorf("getting trajectory: %v", err)
+	}
+	if maxPoints != 0 {
+		points = downsample(points, int(maxPoints))
+	}
+	return points, nil
+}
+
+// downsample picks evenly spaced points, always keeping the first and the last one.
+func downsample(points []apiserver.TrajectoryPoint, maxPoints int) []apiserver.TrajectoryPoint {
+	if len(points) <= maxPoints {
+		return points
+	}
+	sampled := make([]apiserver.TrajectoryPoint, 0, maxPoints)
+	step := float64(len(points)-1) / float64(maxPoints-1)
+	for i := 0; i < maxPoints; i++ {
+		sampled = append(sampled, points[int(float64(i)*step+0.5)])
+	}
+	return sampled
+}
+
+// parseTimeRange parses the optional RFC 3339 bounds of a time range. The range ends now
+// and spans 24 hours unless specified otherwise.
+func parseTimeRange(from string, to string) (time.Time, time.Time, error) {
+	toTime := time.Now()
+	if to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("parsing 'to': %v", err)
+		}
+		toTime = t
+	}
+	fromTime := toTime.Add(-24 * time.Hour)
+	if from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("parsing 'from': %v", err)
+		}
+		fromTime = t
+	}
+	if !fromTime.Before(toTime) {
+		return time.Time{}, time.Time{}, fmt.Errorf("'from' must be before 'to'")
+	}
+	return fromTime, toTime, nil
+}
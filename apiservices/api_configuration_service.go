@@ -18,20 +18,31 @@ package apiservices
 import (
 	"context"
 	"errors"
+	"fmt"
 	"kontakt-io/apiserver"
 	"kontakt-io/conf"
+	kontaktio "kontakt-io/kontakt-io"
+	"kontakt-io/worker"
 	"net/http"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
 )
 
+// defaultRequestTimeout is the request timeout in seconds for draft configurations, matching the
+// default of stored configurations.
+const defaultRequestTimeout = 120
+
 // ConfigurationApiService is a service that implements the logic for the ConfigurationApiServicer
 // This service should implement the business logic for every endpoint for the ConfigurationApi API.
 // Include any external packages or services that will be required by this service.
 type ConfigurationApiService struct {
+	supervisor *worker.Supervisor
 }
 
-// NewConfigurationApiService creates a default api service
-func NewConfigurationApiService() apiserver.ConfigurationApiServicer {
-	return &ConfigurationApiService{}
+// NewConfigurationApiService creates a default api service. The supervisor is notified about
+// changed configurations.
+func NewConfigurationApiService(supervisor *worker.Supervisor) apiserver.ConfigurationApiServicer {
+	return &ConfigurationApiService{supervisor: supervisor}
 }
 
 func (s *ConfigurationApiService) GetConfigurations(ctx context.Context) (apiserver.ImplResponse, error) {
@@ -43,10 +54,17 @@ func (s *ConfigurationApiService) GetConfigurations(ctx context.Context) (apiser
 }
 
 func (s *ConfigurationApiService) PostConfiguration(ctx context.Context, config apiserver.Configuration) (apiserver.ImplResponse, error) {
+	if err := kontaktio.ValidateFilters(config); err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
 	insertedConfig, err := conf.InsertConfig(ctx, config)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
+	s.supervisor.Reload()
 	return apiserver.Response(http.StatusCreated, insertedConfig), nil
 }
 
@@ -62,16 +80,25 @@ func (s *ConfigurationApiService) GetConfigurationById(ctx context.Context, conf
 }
 
 func (s *ConfigurationApiService) PutConfigurationById(ctx context.Context, configId int64, config apiserver.Configuration) (apiserver.ImplResponse, error) {
+	if err := kontaktio.ValidateFilters(config); err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
 	config.Id = &configId
 	upsertedConfig, err := conf.UpsertConfig(ctx, config)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
+	s.supervisor.Reload()
 	return apiserver.Response(http.StatusCreated, upsertedConfig), nil
 }
 
 func (s *ConfigurationApiService) DeleteConfigurationById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
-	err := conf.DeleteConfig(ctx, configId)
+	err := s.supervisor.WithoutWorker(configId, func() error {
+		return conf.DeleteConfig(ctx, configId)
+	})
 	if errors.Is(err, conf.ErrBadRequest) {
 		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
 	}
@@ -80,3 +107,96 @@ func (s *ConfigurationApiService) DeleteConfigurationById(ctx context.Context, c
 	}
 	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
 }
+
+func (s *ConfigurationApiService) PreviewAssetFilter(ctx context.Context, config apiserver.Configuration) (apiserver.ImplResponse, error) {
+	if config.ApiKey == "" {
+		return apiserver.Response(http.StatusBadRequest, "'apiKey' is required to preview the asset filter"), nil
+	}
+	if config.RequestTimeout == nil {
+		config.RequestTimeout = common.Ptr[int32](defaultRequestTimeout)
+	}
+	return previewAssetFilter(ctx, config, config.AssetFilter)
+}
+
+func (s *ConfigurationApiService) PreviewAssetFilterById(ctx context.Context, configId int64, assetFilter *[][]apiserver.FilterRule) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	filter := config.AssetFilter
+	if assetFilter != nil {
+		filter = *assetFilter
+	}
+	return previewAssetFilter(ctx, *config, filter)
+}
+
+func previewAssetFilter(ctx context.Context, config apiserver.Configuration, filter [][]apiserver.FilterRule) (apiserver.ImplResponse, error) {
+	previews, err := kontaktio.PreviewAssetFilter(ctx, config, filter)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("previewing asset filter: %v", err)
+	}
+	result := make([]apiserver.AssetFilterPreview, 0, len(previews))
+	for _, preview := range previews {
+		filterPreview := apiserver.AssetFilterPreview{
+			DeviceId:        preview.Device.ID,
+			Name:            preview.Device.Name,
+			Product:         preview.Device.Product,
+			AssetType:       preview.Device.Type,
+			Properties:      preview.Properties,
+			Included:        preview.Included,
+			MismatchedRules: preview.MismatchedRules,
+		}
+		if preview.MatchedRuleSet >= 0 {
+			filterPreview.MatchedRuleSet = common.Ptr(int32(preview.MatchedRuleSet))
+		}
+		result = append(result, filterPreview)
+	}
+	return apiserver.Response(http.StatusOK, result), nil
+}
+
+func (s *ConfigurationApiService) TestConnection(ctx context.Context, config apiserver.Configuration) (apiserver.ImplResponse, error) {
+	if config.ApiKey == "" {
+		return apiserver.Response(http.StatusBadRequest, "'apiKey' is required to test the connection"), nil
+	}
+	if config.RequestTimeout == nil {
+		config.RequestTimeout = common.Ptr[int32](defaultRequestTimeout)
+	}
+	return testConnection(ctx, config), nil
+}
+
+func (s *ConfigurationApiService) TestConnectionById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return testConnection(ctx, *config), nil
+}
+
+func testConnection(ctx context.Context, config apiserver.Configuration) apiserver.ImplResponse {
+	tests := kontaktio.TestConnection(ctx, config)
+	result := make([]apiserver.ConnectionTestResult, 0, len(tests))
+	for _, test := range tests {
+		testResult := apiserver.ConnectionTestResult{
+			Endpoint:         test.Endpoint,
+			Success:          test.Err == nil,
+			StatusCode:       int32(test.StatusCode),
+			LatencySeconds:   test.Latency.Seconds(),
+			Count:            int32(test.Count),
+			PermissionDenied: test.PermissionDenied,
+		}
+		if test.Err != nil {
+			testResult.Error = test.Err.Error()
+		}
+		result = append(result, testResult)
+	}
+	return apiserver.Response(http.StatusOK, result)
+}
@@ -0,0 +1,71 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/conf"
+	"kontakt-io/eliona"
+	kontaktio "kontakt-io/kontakt-io"
+	"net/http"
+)
+
+// FloorHeightsApiService is a service that implements the logic for the FloorHeightsApiServicer
+// This service should implement the business logic for every endpoint for the FloorHeightsApi API.
+// Include any external packages or services that will be required by this service.
+type FloorHeightsApiService struct {
+}
+
+// NewFloorHeightsApiService creates a default api service
+func NewFloorHeightsApiService() apiserver.FloorHeightsApiServicer {
+	return &FloorHeightsApiService{}
+}
+
+func (s *FloorHeightsApiService) GetFloorHeights(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	floorHeights, err := conf.GetFloorHeights(ctx, configId, kontaktio.FloorAssetType)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, floorHeights), nil
+}
+
+func (s *FloorHeightsApiService) PutFloorHeight(ctx context.Context, assetId int32, floorHeight apiserver.FloorHeight) (apiserver.ImplResponse, error) {
+	if floorHeight.Height == nil {
+		return apiserver.Response(http.StatusBadRequest, "height is required"), nil
+	}
+	location, err := conf.GetFloorLocationByAsset(ctx, assetId, kontaktio.FloorAssetType)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if err := conf.SetFloorHeight(ctx, assetId, *floorHeight.Height); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if err := eliona.UpsertFloorHeightData(ctx, assetId, *floorHeight.Height); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("writing floor height to asset %v: %v", assetId, err)
+	}
+	location.FloorHeight.SetValid(*floorHeight.Height)
+	updatedFloorHeight, err := conf.ApiFloorHeightFromDbLocation(location, kontaktio.FloorAssetType)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, updatedFloorHeight), nil
+}
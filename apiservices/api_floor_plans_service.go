@@ -0,0 +1,68 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"context"
+	"errors"
+	"kontakt-io/apiserver"
+	"kontakt-io/conf"
+	"net/http"
+)
+
+// FloorPlansApiService is a service that implements the logic for the FloorPlansApiServicer
+// This service should implement the business logic for every endpoint for the FloorPlansApi API.
+// Include any external packages or services that will be required by this service.
+type FloorPlansApiService struct {
+}
+
+// NewFloorPlansApiService creates a default api service
+func NewFloorPlansApiService() apiserver.FloorPlansApiServicer {
+	return &FloorPlansApiService{}
+}
+
+func (s *FloorPlansApiService) GetFloorPlans(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	floorPlans, err := conf.GetFloorPlans(ctx, configId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, floorPlans), nil
+}
+
+func (s *FloorPlansApiService) GetFloorPlan(ctx context.Context, configId int64, floorId int32) (apiserver.ImplResponse, error) {
+	floorPlan, err := conf.GetFloorPlan(ctx, configId, floorId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, conf.ApiFloorPlanFromDbFloorPlan(floorPlan)), nil
+}
+
+func (s *FloorPlansApiService) GetFloorPlanImage(ctx context.Context, configId int64, floorId int32) (apiserver.ImplResponse, error) {
+	floorPlan, err := conf.GetFloorPlan(ctx, configId, floorId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if !floorPlan.Image.Valid {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	return apiserver.Response(http.StatusOK, floorPlan.Image.Bytes), nil
+}
@@ -0,0 +1,112 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/conf"
+	"kontakt-io/eliona"
+	"kontakt-io/worker"
+	"net/http"
+	"time"
+)
+
+// healthCheckTimeout limits each check depending on another service.
+const healthCheckTimeout = 5 * time.Second
+
+// HealthApiService is a service that implements the logic for the HealthApiServicer
+// This service should implement the business logic for every endpoint for the HealthApi API.
+// Include any external packages or services that will be required by this service.
+type HealthApiService struct {
+	supervisor  *worker.Supervisor
+	outputs     *eliona.OutputDispatcher
+	maxCycleAge func(config apiserver.Configuration) time.Duration
+}
+
+// NewHealthApiService creates a default api service. A worker is considered stuck if it hasn't
+// completed a cycle within the duration maxCycleAge returns for its configuration.
+func NewHealthApiService(supervisor *worker.Supervisor, outputs *eliona.OutputDispatcher, maxCycleAge func(config apiserver.Configuration) time.Duration) apiserver.HealthApiServicer {
+	return &HealthApiService{
+		supervisor:  supervisor,
+		outputs:     outputs,
+		maxCycleAge: maxCycleAge,
+	}
+}
+
+// GetLiveness fails only if a worker is stuck, as restarting the app doesn't help if another
+// service is unavailable.
+func (s *HealthApiService) GetLiveness(ctx context.Context) (apiserver.ImplResponse, error) {
+	return healthResponse(s.checkWorkers()), nil
+}
+
+func (s *HealthApiService) GetReadiness(ctx context.Context) (apiserver.ImplResponse, error) {
+	return healthResponse(
+		healthCheck("database", withTimeout(ctx, conf.Ping)),
+		healthCheck("eliona_api", withTimeout(ctx, eliona.Ping)),
+		s.checkOutputListener(),
+		s.checkWorkers(),
+	), nil
+}
+
+func (s *HealthApiService) checkOutputListener() apiserver.HealthCheck {
+	var err error
+	if !s.outputs.Connected() {
+		err = fmt.Errorf("not connected to the Eliona output data listener")
+	}
+	return healthCheck("output_listener", err)
+}
+
+func (s *HealthApiService) checkWorkers() apiserver.HealthCheck {
+	var err error
+	if stalled := s.supervisor.Stalled(s.maxCycleAge); len(stalled) > 0 {
+		err = fmt.Errorf("no recent collection cycle for configurations %v", stalled)
+	}
+	return healthCheck("workers", err)
+}
+
+func withTimeout(ctx context.Context, check func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	return check(ctx)
+}
+
+func healthCheck(name string, err error) apiserver.HealthCheck {
+	check := apiserver.HealthCheck{
+		Name:    name,
+		Healthy: err == nil,
+	}
+	if err != nil {
+		check.Error = err.Error()
+	}
+	return check
+}
+
+// healthResponse returns the checks with status 200 if all passed, otherwise with 503.
+func healthResponse(checks ...apiserver.HealthCheck) apiserver.ImplResponse {
+	status := apiserver.HealthStatus{
+		Healthy: true,
+		Checks:  checks,
+	}
+	for _, check := range checks {
+		status.Healthy = status.Healthy && check.Healthy
+	}
+	if !status.Healthy {
+		return apiserver.Response(http.StatusServiceUnavailable, status)
+	}
+	return apiserver.Response(http.StatusOK, status)
+}
@@ -0,0 +1,86 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/conf"
+	"kontakt-io/maintenance"
+	"net/http"
+	"time"
+)
+
+// defaultBatteryForecastDays is the number of days devices due for battery replacement are listed
+// for if not requested otherwise.
+const defaultBatteryForecastDays = 30
+
+// MaintenanceApiService is a service that implements the logic for the MaintenanceApiServicer
+// This service should implement the business logic for every endpoint for the MaintenanceApi API.
+// Include any external packages or services that will be required by this service.
+type MaintenanceApiService struct {
+}
+
+// NewMaintenanceApiService creates a default api service
+func NewMaintenanceApiService() apiserver.MaintenanceApiServicer {
+	return &MaintenanceApiService{}
+}
+
+func (s *MaintenanceApiService) GetBatteryForecasts(ctx context.Context, configId int64, withinDays int32) (apiserver.ImplResponse, error) {
+	if withinDays < 0 {
+		return apiserver.Response(http.StatusBadRequest, "'withinDays' must not be negative"), nil
+	}
+	if withinDays == 0 {
+		withinDays = defaultBatteryForecastDays
+	}
+	forecasts, err := maintenance.GetBatteryForecasts(ctx, configId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("forecasting batteries: %v", err)
+	}
+	now := time.Now()
+	due := now.Add(time.Duration(withinDays) * 24 * time.Hour)
+	result := make([]apiserver.BatteryForecast, 0, len(forecasts))
+	for _, forecast := range forecasts {
+		if forecast.DepletionAt.After(due) {
+			break
+		}
+		result = append(result, apiserver.BatteryForecast{
+			DeviceId:      forecast.DeviceID,
+			BatteryLevel:  forecast.BatteryLevel,
+			DischargeRate: forecast.DischargeRate,
+			DepletionDate: forecast.DepletionAt,
+			DaysLeft:      forecast.DaysLeft(now),
+		})
+	}
+	return apiserver.Response(http.StatusOK, result), nil
+}
+
+func (s *MaintenanceApiService) GetFirmwareInventory(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	products, err := maintenance.GetFirmwareInventory(ctx, *config)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("getting firmware inventory: %v", err)
+	}
+	return apiserver.Response(http.StatusOK, products), nil
+}
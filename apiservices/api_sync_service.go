@@ -0,0 +1,183 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	"kontakt-io/syncrun"
+	"kontakt-io/worker"
+	"net/http"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+// defaultSyncRunsLimit is the number of sync runs listed if not requested otherwise.
+const defaultSyncRunsLimit = 50
+
+// SyncApiService is a service that implements the logic for the SyncApiServicer
+// This service should implement the business logic for every endpoint for the SyncApi API.
+// Include any external packages or services that will be required by this service.
+type SyncApiService struct {
+	supervisor *worker.Supervisor
+}
+
+// NewSyncApiService creates a default api service. The supervisor provides the current errors of
+// the configurations and starts requested runs.
+func NewSyncApiService(supervisor *worker.Supervisor) apiserver.SyncApiServicer {
+	return &SyncApiService{supervisor: supervisor}
+}
+
+func (s *SyncApiService) GetSyncStatus(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	lastRun, err := conf.GetLastFinishedSyncRun(ctx, configId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("getting last finished sync run: %v", err)
+	}
+	lastSuccess, err := conf.GetLastSuccessfulSyncRun(ctx, configId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("getting last successful sync run: %v", err)
+	}
+	status := apiserver.SyncStatus{
+		ConfigId: configId,
+		Enabled:  conf.IsConfigEnabled(*config),
+		Active:   conf.IsConfigActive(*config),
+	}
+	if lastRun != nil {
+		run := apiSyncRunFromDbSyncRun(lastRun)
+		status.LastRun = &run
+		status.Healthy = run.LocationError == "" && run.DeviceError == ""
+	}
+	if lastSuccess != nil {
+		status.LastSuccessAt = common.Ptr(lastSuccess.StartedAt.Time)
+	}
+	if err := s.supervisor.Err(configId); err != nil {
+		status.Error = err.Error()
+		status.Healthy = false
+	}
+	return apiserver.Response(http.StatusOK, status), nil
+}
+
+func (s *SyncApiService) GetSyncRuns(ctx context.Context, configId int64, limit int32) (apiserver.ImplResponse, error) {
+	if limit < 0 {
+		return apiserver.Response(http.StatusBadRequest, "'limit' must not be negative"), nil
+	}
+	if limit == 0 {
+		limit = defaultSyncRunsLimit
+	}
+	if _, err := conf.GetConfig(ctx, configId); errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	runs, err := conf.GetSyncRuns(ctx, configId, int(limit))
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("getting sync runs: %v", err)
+	}
+	result := make([]apiserver.SyncRun, 0, len(runs))
+	for _, run := range runs {
+		result = append(result, apiSyncRunFromDbSyncRun(run))
+	}
+	return apiserver.Response(http.StatusOK, result), nil
+}
+
+func (s *SyncApiService) GetSyncRun(ctx context.Context, configId int64, runId int64) (apiserver.ImplResponse, error) {
+	run, err := conf.GetSyncRun(ctx, configId, runId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("getting sync run: %v", err)
+	}
+	if run == nil {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	return apiserver.Response(http.StatusOK, apiSyncRunFromDbSyncRun(run)), nil
+}
+
+func (s *SyncApiService) TriggerSync(ctx context.Context, configId int64, scope string) (apiserver.ImplResponse, error) {
+	if scope == "" {
+		scope = syncrun.ScopeAll
+	}
+	if !syncrun.ValidScope(scope) {
+		return apiserver.Response(http.StatusBadRequest, fmt.Sprintf("unknown scope '%s'", scope)), nil
+	}
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if !conf.IsConfigEnabled(*config) {
+		return apiserver.Response(http.StatusConflict, fmt.Sprintf("configuration %d is disabled", configId)), nil
+	}
+	run, err := syncrun.Queue(ctx, configId, scope)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("queueing sync run: %v", err)
+	}
+	// Without a worker, e.g. right after enabling the configuration, the run is started by the
+	// first cycle of the worker.
+	s.supervisor.Trigger(configId)
+	return apiserver.Response(http.StatusAccepted, apiSyncRunFromDbSyncRun(run)), nil
+}
+
+func apiSyncRunFromDbSyncRun(run *appdb.SyncRun) apiserver.SyncRun {
+	apiRun := apiserver.SyncRun{
+		Id:             run.ID,
+		Scope:          run.Scope,
+		Status:         syncRunStatus(run),
+		DevicesFetched: run.DevicesFetched,
+		AssetsCreated:  run.AssetsCreated,
+		DataPoints:     run.DataPoints,
+		LocationError:  run.LocationError.String,
+		DeviceError:    run.DeviceError.String,
+	}
+	if run.RequestedAt.Valid {
+		apiRun.RequestedAt = common.Ptr(run.RequestedAt.Time)
+	}
+	if run.StartedAt.Valid {
+		apiRun.StartedAt = common.Ptr(run.StartedAt.Time)
+	}
+	if run.FinishedAt.Valid {
+		apiRun.FinishedAt = common.Ptr(run.FinishedAt.Time)
+	}
+	// Queued runs failed or pruned before they started have no duration.
+	if run.StartedAt.Valid && run.FinishedAt.Valid {
+		apiRun.DurationSeconds = common.Ptr(run.FinishedAt.Time.Sub(run.StartedAt.Time).Seconds())
+	}
+	return apiRun
+}
+
+func syncRunStatus(run *appdb.SyncRun) string {
+	switch {
+	case !run.StartedAt.Valid:
+		return "queued"
+	case !run.FinishedAt.Valid:
+		return "running"
+	case run.LocationError.Valid || run.DeviceError.Valid:
+		return "failed"
+	default:
+		return "succeeded"
+	}
+}
@@ -0,0 +1,95 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"context"
+	"errors"
+	"kontakt-io/apiserver"
+	"kontakt-io/conf"
+	kontaktio "kontakt-io/kontakt-io"
+	"net/http"
+)
+
+// ZonesApiService is a service that implements the logic for the ZonesApiServicer
+// This service should implement the business logic for every endpoint for the ZonesApi API.
+// Include any external packages or services that will be required by this service.
+type ZonesApiService struct {
+}
+
+// NewZonesApiService creates a default api service
+func NewZonesApiService() apiserver.ZonesApiServicer {
+	return &ZonesApiService{}
+}
+
+func (s *ZonesApiService) GetZones(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	zones, err := conf.GetZones(ctx, configId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, zones), nil
+}
+
+func (s *ZonesApiService) PostZone(ctx context.Context, zone apiserver.Zone) (apiserver.ImplResponse, error) {
+	if err := kontaktio.ValidateFilter(zone.AllowedFilter); err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	insertedZone, err := conf.InsertZone(ctx, zone)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusCreated, insertedZone), nil
+}
+
+func (s *ZonesApiService) GetZoneById(ctx context.Context, zoneId int64) (apiserver.ImplResponse, error) {
+	zone, err := conf.GetZone(ctx, zoneId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, zone), nil
+}
+
+func (s *ZonesApiService) PutZoneById(ctx context.Context, zoneId int64, zone apiserver.Zone) (apiserver.ImplResponse, error) {
+	if err := kontaktio.ValidateFilter(zone.AllowedFilter); err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	zone.Id = &zoneId
+	upsertedZone, err := conf.UpsertZone(ctx, zone)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, upsertedZone), nil
+}
+
+func (s *ZonesApiService) DeleteZoneById(ctx context.Context, zoneId int64) (apiserver.ImplResponse, error) {
+	err := conf.DeleteZone(ctx, zoneId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
+}
@@ -17,6 +17,8 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/eliona-smart-building-assistant/go-eliona/app"
 	"github.com/eliona-smart-building-assistant/go-utils/db"
 	"kontakt-io/apiserver"
@@ -24,6 +26,11 @@ import (
 	"kontakt-io/conf"
 	"kontakt-io/eliona"
 	kontaktio "kontakt-io/kontakt-io"
+	"kontakt-io/maintenance"
+	"kontakt-io/metrics"
+	"kontakt-io/syncrun"
+	"kontakt-io/tracking"
+	"kontakt-io/worker"
 	"net/http"
 	"sync"
 	"time"
@@ -31,6 +38,7 @@ import (
 	"github.com/eliona-smart-building-assistant/go-utils/common"
 	utilshttp "github.com/eliona-smart-building-assistant/go-utils/http"
 	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 func initialization() {
@@ -45,32 +53,107 @@ func initialization() {
 		app.ExecSqlFile("conf/init.sql"),
 		eliona.InitEliona,
 	)
+
+	if _, err := conf.FinishInterruptedSyncRuns(ctx); err != nil {
+		log.Error("conf", "finishing interrupted sync runs: %v", err)
+	}
 }
 
 var once sync.Once
 
-// collectData is the main app function which is called periodically
-func collectData() {
-	configs, err := conf.GetConfigs(context.Background())
+// configPollInterval is how often the configurations are read from the database to pick up
+// changes not made through the API.
+const configPollInterval = time.Second
+
+// A collection cycle is canceled after cycleTimeoutIntervals refresh intervals, but not before
+// minCycleTimeout, so that a hanging request can't block a worker indefinitely.
+const (
+	cycleTimeoutIntervals = 5
+	minCycleTimeout       = 5 * time.Minute
+)
+
+// syncRunFinishTimeout bounds recording the end of a collection run.
+const syncRunFinishTimeout = 10 * time.Second
+
+// stallTolerance is added to the longest possible time between two completed cycles, before a
+// worker is reported as stuck by the health endpoints.
+const stallTolerance = time.Minute
+
+// shutdownTimeout is how long the app waits for each service to stop on termination.
+const shutdownTimeout = 30 * time.Second
+
+// cycleStopGrace is how long collection cycles in progress on termination may continue, so that
+// their data is written to Eliona, before they are canceled. Shorter than shutdownTimeout.
+const cycleStopGrace = 10 * time.Second
+
+var supervisor = worker.NewSupervisor(collectConfig, loadConfigs)
+
+var outputDispatcher = eliona.NewOutputDispatcher()
+
+var apiServer = newApiServer()
+
+var (
+	workersStopped        = make(chan struct{})
+	outputListenerStopped = make(chan struct{})
+)
+
+// superviseConfigs runs a collecting worker per enabled configuration and restarts it as soon as
+// its configuration changes. When the context is done, the workers are stopped after their cycles
+// in progress, which are canceled after cycleStopGrace.
+func superviseConfigs(ctx context.Context) {
+	defer close(workersStopped)
+	supervisor.Run(ctx, configPollInterval, cycleStopGrace)
+}
+
+// shutdown stops the app gracefully after the context of the services is done: it waits until the
+// workers finished or canceled their cycles and the output listener is closed, stops the API server
+// and marks all configurations inactive.
+func shutdown() {
+	waitForStop("workers", workersStopped)
+	waitForStop("output listener", outputListenerStopped)
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := apiServer.Shutdown(ctx); err != nil {
+		log.Error("main", "stopping API server: %v", err)
+	}
+	if err := conf.SetAllConfigsInactive(ctx); err != nil {
+		log.Error("conf", "marking configurations inactive: %v", err)
+	}
+}
+
+func waitForStop(service string, stopped chan struct{}) {
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		log.Warn("main", "The %s did not stop within %v.", service, shutdownTimeout)
+	}
+}
+
+// loadConfigs returns the enabled configurations and maintains their active state. Failing to
+// update the active state is reported per configuration and retried with the next load.
+func loadConfigs(ctx context.Context) ([]apiserver.Configuration, map[int64]error, error) {
+	configs, err := conf.GetConfigs(ctx)
 	if err != nil {
-		log.Fatal("conf", "Couldn't read configs from DB: %v", err)
-		return
+		return nil, nil, fmt.Errorf("reading configs from DB: %v", err)
 	}
 	if len(configs) == 0 {
 		once.Do(func() {
 			log.Info("conf", "No configs in DB. Please configure the app in Eliona.")
 		})
-		return
+		return nil, nil, nil
 	}
 
+	var enabled []apiserver.Configuration
+	configErrors := make(map[int64]error)
 	for _, config := range configs {
 		// Skip config if disabled and set inactive
 		if !conf.IsConfigEnabled(config) {
 			if conf.IsConfigActive(config) {
-				_, err := conf.SetConfigActiveState(context.Background(), config, false)
+				_, err := conf.SetConfigActiveState(ctx, config, false)
 				if err != nil {
-					log.Fatal("conf", "Couldn't set config active state to DB: %v", err)
-					return
+					log.Error("conf", "Couldn't set config active state to DB: %v", err)
+					configErrors[*config.Id] = fmt.Errorf("setting config inactive: %v", err)
 				}
 			}
 			continue
@@ -78,111 +161,246 @@ func collectData() {
 
 		// Signals that this config is active
 		if !conf.IsConfigActive(config) {
-			_, err := conf.SetConfigActiveState(context.Background(), config, true)
+			_, err := conf.SetConfigActiveState(ctx, config, true)
 			if err != nil {
-				log.Fatal("conf", "Couldn't set config active state to DB: %v", err)
-				return
+				log.Error("conf", "Couldn't set config active state to DB: %v", err)
+				configErrors[*config.Id] = fmt.Errorf("setting config active: %v", err)
+			} else {
+				log.Info("conf", "Collecting initialized with Configuration %d:\n"+
+					"API Key: %s\n"+
+					"Enable: %t\n"+
+					"Refresh Interval: %d\n"+
+					"Request Timeout: %d\n"+
+					"Project IDs: %v\n",
+					*config.Id,
+					config.ApiKey,
+					*config.Enable,
+					config.RefreshInterval,
+					*config.RequestTimeout,
+					conf.ProjIds(config))
 			}
-			log.Info("conf", "Collecting initialized with Configuration %d:\n"+
-				"API Key: %s\n"+
-				"Enable: %t\n"+
-				"Refresh Interval: %d\n"+
-				"Request Timeout: %d\n"+
-				"Active: %t\n"+
-				"Project IDs: %v\n",
-				*config.Id,
-				config.ApiKey,
-				*config.Enable,
-				config.RefreshInterval,
-				*config.RequestTimeout,
-				*config.Active,
-				*config.ProjectIDs)
 		}
+		enabled = append(enabled, config)
+	}
+	return enabled, configErrors, nil
+}
 
-		common.RunOnceWithParam(func(config apiserver.Configuration) {
-			log.Info("main", "Collecting %d started", *config.Id)
+// collectConfig is one collection cycle of a configuration, called periodically by its worker.
+func collectConfig(ctx context.Context, config apiserver.Configuration) error {
+	ctx, cancel := context.WithTimeout(ctx, cycleTimeout(config))
+	defer cancel()
 
-			if err := collectLocations(config); err != nil {
-				return // Error is handled in the method itself.
-			}
-			if err := collectDevices(config); err != nil {
-				return // Error is handled in the method itself.
-			}
+	log.Info("main", "Collecting %d started", *config.Id)
+	ctx = metrics.WithConfig(ctx, *config.Id)
+	ctx, run := syncrun.Start(ctx, *config.Id)
+
+	scope := run.Scope()
+	var locationErr, deviceErr error
+	locationsCollected := true
+	if scope != syncrun.ScopeDevices {
+		if err := collectLocations(ctx, config); err != nil {
+			locationErr = fmt.Errorf("collecting locations: %v", err) // Error is logged in the method itself.
+			locationsCollected = false
+		} else if err := tracking.ImportFloorPlans(ctx, config); err != nil {
+			// Floor plans are only needed for heatmaps, the devices are collected anyway.
+			log.Error("tracking", "importing floor plans: %v", err)
+			locationErr = fmt.Errorf("importing floor plans: %v", err)
+		}
+	}
+	if locationsCollected && scope != syncrun.ScopeLocations {
+		if ctx.Err() != nil {
+			log.Info("main", "Collecting %d canceled", *config.Id)
+			deviceErr = ctx.Err()
+		} else if err := collectDevices(ctx, config, scope == syncrun.ScopeReconcile); err != nil {
+			deviceErr = fmt.Errorf("collecting devices: %v", err) // Error is logged in the method itself.
+		}
+	}
 
-			log.Info("main", "Collecting %d finished", *config.Id)
+	// The run is recorded even if the cycle timed out or was canceled.
+	finishCtx, cancelFinish := context.WithTimeout(context.Background(), syncRunFinishTimeout)
+	defer cancelFinish()
+	run.Finish(finishCtx, locationErr, deviceErr)
+	// Runs requested during the cycle are started right after it instead of by the next
+	// scheduled cycles.
+	if queued, err := conf.GetQueuedSyncRun(finishCtx, *config.Id); err != nil {
+		log.Error("conf", "finding queued sync run for config %d: %v", *config.Id, err)
+	} else if queued != nil {
+		worker.TriggerNext(ctx)
+	}
+	if locationErr != nil {
+		return locationErr
+	}
+	if deviceErr != nil {
+		return deviceErr
+	}
+	log.Info("main", "Collecting %d finished", *config.Id)
+	return nil
+}
 
-			time.Sleep(time.Second * time.Duration(config.RefreshInterval))
-		}, config, *config.Id)
+func cycleTimeout(config apiserver.Configuration) time.Duration {
+	timeout := cycleTimeoutIntervals * time.Duration(config.RefreshInterval) * time.Second
+	if timeout < minCycleTimeout {
+		return minCycleTimeout
 	}
+	return timeout
+}
+
+// maxCycleAge is the longest time a worker may go without completing a cycle: the refresh interval
+// and a cycle canceled by its timeout, which still records its run.
+func maxCycleAge(config apiserver.Configuration) time.Duration {
+	return time.Duration(config.RefreshInterval)*time.Second + cycleTimeout(config) + syncRunFinishTimeout + stallTolerance
 }
 
-func collectLocations(config apiserver.Configuration) error {
-	rooms, err := kontaktio.GetRooms(config)
+func collectLocations(ctx context.Context, config apiserver.Configuration) error {
+	rooms, err := kontaktio.GetRooms(ctx, config)
 	if err != nil {
 		log.Error("kontakt-io", "getting rooms: %v", err)
 		return err
 	}
-	if err := eliona.CreateLocationAssetsIfNecessary(config, rooms); err != nil {
+	rooms, err = kontaktio.FilterRooms(config, rooms)
+	if err != nil {
+		log.Error("kontakt-io", "filtering rooms: %v", err)
+		return err
+	}
+	rooms, err = kontaktio.MapRoomsToProjects(config, rooms)
+	if err != nil {
+		log.Error("kontakt-io", "mapping rooms to projects: %v", err)
+		return err
+	}
+	if err := eliona.CreateLocationAssetsIfNecessary(ctx, config, rooms); err != nil {
 		log.Error("eliona", "creating location assets: %v", err)
 		return err
 	}
 
-	if err := eliona.UpsertLocationData(config, rooms); err != nil {
+	if err := eliona.UpsertLocationData(ctx, config, rooms); err != nil {
 		log.Error("eliona", "inserting location data into Eliona: %v", err)
 		return err
 	}
 	return nil
 }
 
-func collectDevices(config apiserver.Configuration) error {
-	devices, err := kontaktio.GetDevices(config)
+// collectDevices collects the devices reporting recently. With reconcile, assets are created for
+// all devices passing the filters, even if they haven't reported recently.
+func collectDevices(ctx context.Context, config apiserver.Configuration, reconcile bool) error {
+	devices, inventory, err := kontaktio.GetDevices(ctx, config)
 	if err != nil {
 		log.Error("kontakt-io", "getting devices info: %v", err)
 		return err
 	}
-	if err := eliona.CreateDeviceAssetsIfNecessary(config, devices); err != nil {
+	syncrun.AddDevicesFetched(ctx, len(devices))
+	if err := eliona.CreateDeviceAssetsIfNecessary(ctx, config, devices); err != nil {
 		log.Error("eliona", "creating tag assets: %v", err)
 		return err
 	}
-	if err := eliona.UpsertDeviceData(config, devices); err != nil {
+	if err := eliona.UpsertDeviceData(ctx, config, devices); err != nil {
 		log.Error("eliona", "inserting location data into Eliona: %v", err)
 		return err
 	}
+	if err := eliona.UpsertOfflineDeviceData(ctx, config, devices); err != nil {
+		log.Error("eliona", "marking offline devices: %v", err)
+		return err
+	}
+	if err := maintenance.RecordBatteryLevels(ctx, config, devices); err != nil {
+		log.Error("maintenance", "recording battery levels: %v", err)
+		return err
+	}
+	devicesByProduct := make(map[string]int)
+	for _, device := range inventory {
+		devicesByProduct[device.Product]++
+	}
+	metrics.SetDevices(*config.Id, devicesByProduct)
+	if reconcile {
+		if err := reconcileDeviceAssets(ctx, config, inventory); err != nil {
+			log.Error("eliona", "reconciling device assets: %v", err)
+			return err
+		}
+	}
+	if err := maintenance.UpdateDeviceInventory(ctx, config, inventory, devices); err != nil {
+		log.Error("maintenance", "updating device inventory: %v", err)
+		return err
+	}
+	if err := maintenance.UpsertFirmwareStatus(ctx, config, inventory); err != nil {
+		log.Error("maintenance", "upserting firmware status: %v", err)
+		return err
+	}
+	if err := eliona.SyncDeviceSettings(ctx, config, devices); err != nil {
+		log.Error("eliona", "synchronizing device settings: %v", err)
+		return err
+	}
+	if err := tracking.ProcessZones(ctx, config, devices); err != nil {
+		log.Error("tracking", "processing zones: %v", err)
+		return err
+	}
+	if err := tracking.RecordPositions(ctx, config, devices); err != nil {
+		log.Error("tracking", "recording positions: %v", err)
+		return err
+	}
+	if err := tracking.ProcessVisits(ctx, config, devices); err != nil {
+		log.Error("tracking", "processing room visits: %v", err)
+		return err
+	}
+	if err := tracking.UpsertDailyRoomDwellTimes(ctx, config); err != nil {
+		log.Error("tracking", "upserting room dwell times: %v", err)
+		return err
+	}
 	return nil
 }
 
-func listenForOutputChanges() {
-	for { // We want to restart listening in case something breaks.
-		outputs, err := eliona.ListenForOutputChanges()
-		if err != nil {
-			log.Error("eliona", "listening for output changes: %v", err)
-			return
-		}
-		for output := range outputs {
-			// TODO: Filter for only own asset types.
-			height, ok := output.Data["height"]
-			log.Info("output", "%+v\n%+v", output.Data, height)
-			if !ok {
-				log.Debug("eliona", "no 'height' attribute in data: %+v", output)
-				continue
-			}
-			if err := conf.SetFloorHeight(output.AssetId, height.(float64)); err != nil {
-				log.Error("conf", "setting floor height: %v", err)
-				continue
-			}
+// reconcileDeviceAssets creates the missing assets of all devices of the inventory passing the
+// filters.
+func reconcileDeviceAssets(ctx context.Context, config apiserver.Configuration, inventory []kontaktio.Device) error {
+	var devices []kontaktio.Device
+	for _, device := range inventory {
+		if device.MatchesAssetFilter && device.Type != "" {
+			devices = append(devices, device)
 		}
-		time.Sleep(time.Second * 5) // Give the server a little break.
 	}
+	return eliona.CreateDeviceAssetsIfNecessary(ctx, config, devices)
 }
 
-// listenApi starts the API server and listen for requests
+func listenForOutputChanges(ctx context.Context) {
+	defer close(outputListenerStopped)
+	outputDispatcher.Handle(kontaktio.FloorAssetType, "height", eliona.FloatOutputHandler(setFloorHeight))
+	eliona.HandleDeviceSettingOutputs(outputDispatcher)
+	outputDispatcher.Listen(ctx)
+}
+
+func setFloorHeight(ctx context.Context, assetId int32, height float64) error {
+	if _, err := conf.GetFloorLocationByAsset(ctx, assetId, kontaktio.FloorAssetType); err != nil {
+		return err
+	}
+	if err := conf.SetFloorHeight(ctx, assetId, height); err != nil {
+		return fmt.Errorf("setting floor height: %v", err)
+	}
+	return nil
+}
+
+// listenApi starts the API server and listen for requests until it is shut down
 func listenApi() {
-	err := http.ListenAndServe(":"+common.Getenv("API_SERVER_PORT", "3000"), utilshttp.NewCORSEnabledHandler(
-		apiserver.NewRouter(
-			apiserver.NewConfigurationApiController(apiservices.NewConfigurationApiService()),
-			apiserver.NewVersionApiController(apiservices.NewVersionApiService()),
-			apiserver.NewCustomizationApiController(apiservices.NewCustomizationApiService()),
-		)),
-	)
+	err := apiServer.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return
+	}
 	log.Fatal("main", "API server: %v", err)
 }
+
+func newApiServer() *http.Server {
+	router := apiserver.NewRouter(
+		apiserver.NewConfigurationApiController(apiservices.NewConfigurationApiService(supervisor)),
+		apiserver.NewVersionApiController(apiservices.NewVersionApiService()),
+		apiserver.NewCustomizationApiController(apiservices.NewCustomizationApiService()),
+		apiserver.NewZonesApiController(apiservices.NewZonesApiService()),
+		apiserver.NewAnalyticsApiController(apiservices.NewAnalyticsApiService()),
+		apiserver.NewFloorPlansApiController(apiservices.NewFloorPlansApiService()),
+		apiserver.NewFloorHeightsApiController(apiservices.NewFloorHeightsApiService()),
+		apiserver.NewInventoryApiController(apiservices.NewInventoryApiService()),
+		apiserver.NewMaintenanceApiController(apiservices.NewMaintenanceApiService()),
+		apiserver.NewSyncApiController(apiservices.NewSyncApiService(supervisor)),
+		apiserver.NewHealthApiController(apiservices.NewHealthApiService(supervisor, outputDispatcher, maxCycleAge)),
+	)
+	router.Handle("/metrics", promhttp.Handler())
+	return &http.Server{
+		Addr:    ":" + common.Getenv("API_SERVER_PORT", "3000"),
+		Handler: utilshttp.NewCORSEnabledHandler(router),
+	}
+}
@@ -0,0 +1,1217 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// BatteryLevel is an object representing the database table.
+type BatteryLevel struct {
+	ConfigurationID int64     `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	DeviceID        string    `boil:"device_id" json:"device_id" toml:"device_id" yaml:"device_id"`
+	RecordedAt      time.Time `boil:"recorded_at" json:"recorded_at" toml:"recorded_at" yaml:"recorded_at"`
+	BatteryLevel    int32     `boil:"battery_level" json:"battery_level" toml:"battery_level" yaml:"battery_level"`
+
+	R *batteryLevelR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L batteryLevelL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BatteryLevelColumns = struct {
+	ConfigurationID string
+	DeviceID        string
+	RecordedAt      string
+	BatteryLevel    string
+}{
+	ConfigurationID: "configuration_id",
+	DeviceID:        "device_id",
+	RecordedAt:      "recorded_at",
+	BatteryLevel:    "battery_level",
+}
+
+var BatteryLevelTableColumns = struct {
+	ConfigurationID string
+	DeviceID        string
+	RecordedAt      string
+	BatteryLevel    string
+}{
+	ConfigurationID: "battery_level.configuration_id",
+	DeviceID:        "battery_level.device_id",
+	RecordedAt:      "battery_level.recorded_at",
+	BatteryLevel:    "battery_level.battery_level",
+}
+
+// Generated where
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var BatteryLevelWhere = struct {
+	ConfigurationID whereHelperint64
+	DeviceID        whereHelperstring
+	RecordedAt      whereHelpertime_Time
+	BatteryLevel    whereHelperint32
+}{
+	ConfigurationID: whereHelperint64{field: "\"kontakt_io\".\"battery_level\".\"configuration_id\""},
+	DeviceID:        whereHelperstring{field: "\"kontakt_io\".\"battery_level\".\"device_id\""},
+	RecordedAt:      whereHelpertime_Time{field: "\"kontakt_io\".\"battery_level\".\"recorded_at\""},
+	BatteryLevel:    whereHelperint32{field: "\"kontakt_io\".\"battery_level\".\"battery_level\""},
+}
+
+// BatteryLevelRels is where relationship names are stored.
+var BatteryLevelRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// batteryLevelR is where relationships are stored.
+type batteryLevelR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*batteryLevelR) NewStruct() *batteryLevelR {
+	return &batteryLevelR{}
+}
+
+func (r *batteryLevelR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// batteryLevelL is where Load methods for each relationship are stored.
+type batteryLevelL struct{}
+
+var (
+	batteryLevelAllColumns            = []string{"configuration_id", "device_id", "recorded_at", "battery_level"}
+	batteryLevelColumnsWithoutDefault = []string{"configuration_id", "device_id", "recorded_at", "battery_level"}
+	batteryLevelColumnsWithDefault    = []string{}
+	batteryLevelPrimaryKeyColumns     = []string{"configuration_id", "device_id", "recorded_at"}
+	batteryLevelGeneratedColumns      = []string{}
+)
+
+type (
+	// BatteryLevelSlice is an alias for a slice of pointers to BatteryLevel.
+	// This should almost always be used instead of []BatteryLevel.
+	BatteryLevelSlice []*BatteryLevel
+	// BatteryLevelHook is the signature for custom BatteryLevel hook methods
+	BatteryLevelHook func(context.Context, boil.ContextExecutor, *BatteryLevel) error
+
+	batteryLevelQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	batteryLevelType                 = reflect.TypeOf(&BatteryLevel{})
+	batteryLevelMapping              = queries.MakeStructMapping(batteryLevelType)
+	batteryLevelPrimaryKeyMapping, _ = queries.BindMapping(batteryLevelType, batteryLevelMapping, batteryLevelPrimaryKeyColumns)
+	batteryLevelInsertCacheMut       sync.RWMutex
+	batteryLevelInsertCache          = make(map[string]insertCache)
+	batteryLevelUpdateCacheMut       sync.RWMutex
+	batteryLevelUpdateCache          = make(map[string]updateCache)
+	batteryLevelUpsertCacheMut       sync.RWMutex
+	batteryLevelUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var batteryLevelAfterSelectHooks []BatteryLevelHook
+
+var batteryLevelBeforeInsertHooks []BatteryLevelHook
+var batteryLevelAfterInsertHooks []BatteryLevelHook
+
+var batteryLevelBeforeUpdateHooks []BatteryLevelHook
+var batteryLevelAfterUpdateHooks []BatteryLevelHook
+
+var batteryLevelBeforeDeleteHooks []BatteryLevelHook
+var batteryLevelAfterDeleteHooks []BatteryLevelHook
+
+var batteryLevelBeforeUpsertHooks []BatteryLevelHook
+var batteryLevelAfterUpsertHooks []BatteryLevelHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BatteryLevel) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batteryLevelAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BatteryLevel) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batteryLevelBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BatteryLevel) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batteryLevelAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BatteryLevel) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batteryLevelBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BatteryLevel) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batteryLevelAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BatteryLevel) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batteryLevelBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BatteryLevel) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batteryLevelAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BatteryLevel) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batteryLevelBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BatteryLevel) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batteryLevelAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBatteryLevelHook registers your hook function for all future operations.
+func AddBatteryLevelHook(hookPoint boil.HookPoint, batteryLevelHook BatteryLevelHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		batteryLevelAfterSelectHooks = append(batteryLevelAfterSelectHooks, batteryLevelHook)
+	case boil.BeforeInsertHook:
+		batteryLevelBeforeInsertHooks = append(batteryLevelBeforeInsertHooks, batteryLevelHook)
+	case boil.AfterInsertHook:
+		batteryLevelAfterInsertHooks = append(batteryLevelAfterInsertHooks, batteryLevelHook)
+	case boil.BeforeUpdateHook:
+		batteryLevelBeforeUpdateHooks = append(batteryLevelBeforeUpdateHooks, batteryLevelHook)
+	case boil.AfterUpdateHook:
+		batteryLevelAfterUpdateHooks = append(batteryLevelAfterUpdateHooks, batteryLevelHook)
+	case boil.BeforeDeleteHook:
+		batteryLevelBeforeDeleteHooks = append(batteryLevelBeforeDeleteHooks, batteryLevelHook)
+	case boil.AfterDeleteHook:
+		batteryLevelAfterDeleteHooks = append(batteryLevelAfterDeleteHooks, batteryLevelHook)
+	case boil.BeforeUpsertHook:
+		batteryLevelBeforeUpsertHooks = append(batteryLevelBeforeUpsertHooks, batteryLevelHook)
+	case boil.AfterUpsertHook:
+		batteryLevelAfterUpsertHooks = append(batteryLevelAfterUpsertHooks, batteryLevelHook)
+	}
+}
+
+// OneG returns a single batteryLevel record from the query using the global executor.
+func (q batteryLevelQuery) OneG(ctx context.Context) (*BatteryLevel, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single batteryLevel record from the query.
+func (q batteryLevelQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BatteryLevel, error) {
+	o := &BatteryLevel{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for battery_level")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all BatteryLevel records from the query using the global executor.
+func (q batteryLevelQuery) AllG(ctx context.Context) (BatteryLevelSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all BatteryLevel records from the query.
+func (q batteryLevelQuery) All(ctx context.Context, exec boil.ContextExecutor) (BatteryLevelSlice, error) {
+	var o []*BatteryLevel
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to BatteryLevel slice")
+	}
+
+	if len(batteryLevelAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all BatteryLevel records in the query using the global executor
+func (q batteryLevelQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all BatteryLevel records in the query.
+func (q batteryLevelQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count battery_level rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q batteryLevelQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q batteryLevelQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if battery_level exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *BatteryLevel) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (batteryLevelL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBatteryLevel interface{}, mods queries.Applicator) error {
+	var slice []*BatteryLevel
+	var object *BatteryLevel
+
+	if singular {
+		var ok bool
+		object, ok = maybeBatteryLevel.(*BatteryLevel)
+		if !ok {
+			object = new(BatteryLevel)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBatteryLevel)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBatteryLevel))
+			}
+		}
+	} else {
+		s, ok := maybeBatteryLevel.(*[]*BatteryLevel)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBatteryLevel)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBatteryLevel))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &batteryLevelR{}
+		}
+		args = append(args, object.ConfigurationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &batteryLevelR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ConfigurationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ConfigurationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.configuration`),
+		qm.WhereIn(`kontakt_io.configuration.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.BatteryLevels = append(foreign.R.BatteryLevels, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.BatteryLevels = append(foreign.R.BatteryLevels, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the batteryLevel to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.BatteryLevels.
+// Uses the global database handle.
+func (o *BatteryLevel) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the batteryLevel to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.BatteryLevels.
+func (o *BatteryLevel) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kontakt_io\".\"battery_level\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, batteryLevelPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConfigurationID, o.DeviceID, o.RecordedAt}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &batteryLevelR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			BatteryLevels: BatteryLevelSlice{o},
+		}
+	} else {
+		related.R.BatteryLevels = append(related.R.BatteryLevels, o)
+	}
+
+	return nil
+}
+
+// BatteryLevels retrieves all the records using an executor.
+func BatteryLevels(mods ...qm.QueryMod) batteryLevelQuery {
+	mods = append(mods, qm.From("\"kontakt_io\".\"battery_level\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kontakt_io\".\"battery_level\".*"})
+	}
+
+	return batteryLevelQuery{q}
+}
+
+// FindBatteryLevelG retrieves a single record by ID.
+func FindBatteryLevelG(ctx context.Context, configurationID int64, deviceID string, recordedAt time.Time, selectCols ...string) (*BatteryLevel, error) {
+	return FindBatteryLevel(ctx, boil.GetContextDB(), configurationID, deviceID, recordedAt, selectCols...)
+}
+
+// FindBatteryLevel retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBatteryLevel(ctx context.Context, exec boil.ContextExecutor, configurationID int64, deviceID string, recordedAt time.Time, selectCols ...string) (*BatteryLevel, error) {
+	batteryLevelObj := &BatteryLevel{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kontakt_io\".\"battery_level\" where \"configuration_id\"=$1 AND \"device_id\"=$2 AND \"recorded_at\"=$3", sel,
+	)
+
+	q := queries.Raw(query, configurationID, deviceID, recordedAt)
+
+	err := q.Bind(ctx, exec, batteryLevelObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from battery_level")
+	}
+
+	if err = batteryLevelObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return batteryLevelObj, err
+	}
+
+	return batteryLevelObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *BatteryLevel) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BatteryLevel) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no battery_level provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(batteryLevelColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	batteryLevelInsertCacheMut.RLock()
+	cache, cached := batteryLevelInsertCache[key]
+	batteryLevelInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			batteryLevelAllColumns,
+			batteryLevelColumnsWithDefault,
+			batteryLevelColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(batteryLevelType, batteryLevelMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(batteryLevelType, batteryLevelMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kontakt_io\".\"battery_level\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kontakt_io\".\"battery_level\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into battery_level")
+	}
+
+	if !cached {
+		batteryLevelInsertCacheMut.Lock()
+		batteryLevelInsertCache[key] = cache
+		batteryLevelInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single BatteryLevel record using the global executor.
+// See Update for more documentation.
+func (o *BatteryLevel) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the BatteryLevel.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BatteryLevel) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	batteryLevelUpdateCacheMut.RLock()
+	cache, cached := batteryLevelUpdateCache[key]
+	batteryLevelUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			batteryLevelAllColumns,
+			batteryLevelPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update battery_level, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kontakt_io\".\"battery_level\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, batteryLevelPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(batteryLevelType, batteryLevelMapping, append(wl, batteryLevelPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update battery_level row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for battery_level")
+	}
+
+	if !cached {
+		batteryLevelUpdateCacheMut.Lock()
+		batteryLevelUpdateCache[key] = cache
+		batteryLevelUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q batteryLevelQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q batteryLevelQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for battery_level")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for battery_level")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o BatteryLevelSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BatteryLevelSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), batteryLevelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kontakt_io\".\"battery_level\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, batteryLevelPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in batteryLevel slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all batteryLevel")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *BatteryLevel) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BatteryLevel) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no battery_level provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(batteryLevelColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	batteryLevelUpsertCacheMut.RLock()
+	cache, cached := batteryLevelUpsertCache[key]
+	batteryLevelUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			batteryLevelAllColumns,
+			batteryLevelColumnsWithDefault,
+			batteryLevelColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			batteryLevelAllColumns,
+			batteryLevelPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert battery_level, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(batteryLevelPrimaryKeyColumns))
+			copy(conflict, batteryLevelPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kontakt_io\".\"battery_level\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(batteryLevelType, batteryLevelMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(batteryLevelType, batteryLevelMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert battery_level")
+	}
+
+	if !cached {
+		batteryLevelUpsertCacheMut.Lock()
+		batteryLevelUpsertCache[key] = cache
+		batteryLevelUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single BatteryLevel record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *BatteryLevel) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single BatteryLevel record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BatteryLevel) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no BatteryLevel provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), batteryLevelPrimaryKeyMapping)
+	sql := "DELETE FROM \"kontakt_io\".\"battery_level\" WHERE \"configuration_id\"=$1 AND \"device_id\"=$2 AND \"recorded_at\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from battery_level")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for battery_level")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q batteryLevelQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q batteryLevelQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no batteryLevelQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from battery_level")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for battery_level")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o BatteryLevelSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BatteryLevelSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(batteryLevelBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), batteryLevelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kontakt_io\".\"battery_level\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, batteryLevelPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from batteryLevel slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for battery_level")
+	}
+
+	if len(batteryLevelAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *BatteryLevel) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no BatteryLevel provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BatteryLevel) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBatteryLevel(ctx, exec, o.ConfigurationID, o.DeviceID, o.RecordedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BatteryLevelSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty BatteryLevelSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BatteryLevelSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BatteryLevelSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), batteryLevelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kontakt_io\".\"battery_level\".* FROM \"kontakt_io\".\"battery_level\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, batteryLevelPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in BatteryLevelSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BatteryLevelExistsG checks if the BatteryLevel row exists.
+func BatteryLevelExistsG(ctx context.Context, configurationID int64, deviceID string, recordedAt time.Time) (bool, error) {
+	return BatteryLevelExists(ctx, boil.GetContextDB(), configurationID, deviceID, recordedAt)
+}
+
+// BatteryLevelExists checks if the BatteryLevel row exists.
+func BatteryLevelExists(ctx context.Context, exec boil.ContextExecutor, configurationID int64, deviceID string, recordedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kontakt_io\".\"battery_level\" where \"configuration_id\"=$1 AND \"device_id\"=$2 AND \"recorded_at\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, configurationID, deviceID, recordedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, configurationID, deviceID, recordedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if battery_level exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BatteryLevel row exists.
+func (o *BatteryLevel) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BatteryLevelExists(ctx, exec, o.ConfigurationID, o.DeviceID, o.RecordedAt)
+}
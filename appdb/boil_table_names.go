@@ -4,11 +4,31 @@
 package appdb
 
 var TableNames = struct {
-	Configuration string
-	Location      string
-	Tag           string
+	AlarmRule       string
+	BatteryLevel    string
+	Configuration   string
+	DeviceInventory string
+	DeviceSetting   string
+	FloorPlan       string
+	Location        string
+	Position        string
+	RoomVisit       string
+	SyncRun         string
+	Tag             string
+	Zone            string
+	ZonePresence    string
 }{
-	Configuration: "configuration",
-	Location:      "location",
-	Tag:           "tag",
+	AlarmRule:       "alarm_rule",
+	BatteryLevel:    "battery_level",
+	Configuration:   "configuration",
+	DeviceInventory: "device_inventory",
+	DeviceSetting:   "device_setting",
+	FloorPlan:       "floor_plan",
+	Location:        "location",
+	Position:        "position",
+	RoomVisit:       "room_visit",
+	SyncRun:         "sync_run",
+	Tag:             "tag",
+	Zone:            "zone",
+	ZonePresence:    "zone_presence",
 }
@@ -25,94 +25,96 @@ import (
 
 // Configuration is an object representing the database table.
 type Configuration struct {
-	ID              int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
-	APIKey          null.String       `boil:"api_key" json:"api_key,omitempty" toml:"api_key" yaml:"api_key,omitempty"`
-	AbsoluteX       float64           `boil:"absolute_x" json:"absolute_x" toml:"absolute_x" yaml:"absolute_x"`
-	AbsoluteY       float64           `boil:"absolute_y" json:"absolute_y" toml:"absolute_y" yaml:"absolute_y"`
-	RefreshInterval int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
-	RequestTimeout  int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
-	AssetFilter     null.JSON         `boil:"asset_filter" json:"asset_filter,omitempty" toml:"asset_filter" yaml:"asset_filter,omitempty"`
-	Active          null.Bool         `boil:"active" json:"active,omitempty" toml:"active" yaml:"active,omitempty"`
-	Enable          null.Bool         `boil:"enable" json:"enable,omitempty" toml:"enable" yaml:"enable,omitempty"`
-	ProjectIds      types.StringArray `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
+	ID                int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	APIKey            null.String       `boil:"api_key" json:"api_key,omitempty" toml:"api_key" yaml:"api_key,omitempty"`
+	AbsoluteX         float64           `boil:"absolute_x" json:"absolute_x" toml:"absolute_x" yaml:"absolute_x"`
+	AbsoluteY         float64           `boil:"absolute_y" json:"absolute_y" toml:"absolute_y" yaml:"absolute_y"`
+	RefreshInterval   int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
+	RequestTimeout    int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
+	AssetFilter       null.JSON         `boil:"asset_filter" json:"asset_filter,omitempty" toml:"asset_filter" yaml:"asset_filter,omitempty"`
+	Active            null.Bool         `boil:"active" json:"active,omitempty" toml:"active" yaml:"active,omitempty"`
+	Enable            null.Bool         `boil:"enable" json:"enable,omitempty" toml:"enable" yaml:"enable,omitempty"`
+	ProjectIds        types.StringArray `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
+	PositionRetention int32             `boil:"position_retention" json:"position_retention" toml:"position_retention" yaml:"position_retention"`
+	AlarmThresholds   null.JSON         `boil:"alarm_thresholds" json:"alarm_thresholds,omitempty" toml:"alarm_thresholds" yaml:"alarm_thresholds,omitempty"`
+	FirmwareVersions  null.JSON         `boil:"firmware_versions" json:"firmware_versions,omitempty" toml:"firmware_versions" yaml:"firmware_versions,omitempty"`
+	LocationFilter    null.JSON         `boil:"location_filter" json:"location_filter,omitempty" toml:"location_filter" yaml:"location_filter,omitempty"`
+	ProjectMappings   null.JSON         `boil:"project_mappings" json:"project_mappings,omitempty" toml:"project_mappings" yaml:"project_mappings,omitempty"`
 
 	R *configurationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L configurationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ConfigurationColumns = struct {
-	ID              string
-	APIKey          string
-	AbsoluteX       string
-	AbsoluteY       string
-	RefreshInterval string
-	RequestTimeout  string
-	AssetFilter     string
-	Active          string
-	Enable          string
-	ProjectIds      string
+	ID                string
+	APIKey            string
+	AbsoluteX         string
+	AbsoluteY         string
+	RefreshInterval   string
+	RequestTimeout    string
+	AssetFilter       string
+	Active            string
+	Enable            string
+	ProjectIds        string
+	PositionRetention string
+	AlarmThresholds   string
+	FirmwareVersions  string
+	LocationFilter    string
+	ProjectMappings   string
 }{
-	ID:              "id",
-	APIKey:          "api_key",
-	AbsoluteX:       "absolute_x",
-	AbsoluteY:       "absolute_y",
-	RefreshInterval: "refresh_interval",
-	RequestTimeout:  "request_timeout",
-	AssetFilter:     "asset_filter",
-	Active:          "active",
-	Enable:          "enable",
-	ProjectIds:      "project_ids",
+	ID:                "id",
+	APIKey:            "api_key",
+	AbsoluteX:         "absolute_x",
+	AbsoluteY:         "absolute_y",
+	RefreshInterval:   "refresh_interval",
+	RequestTimeout:    "request_timeout",
+	AssetFilter:       "asset_filter",
+	Active:            "active",
+	Enable:            "enable",
+	ProjectIds:        "project_ids",
+	PositionRetention: "position_retention",
+	AlarmThresholds:   "alarm_thresholds",
+	FirmwareVersions:  "firmware_versions",
+	LocationFilter:    "location_filter",
+	ProjectMappings:   "project_mappings",
 }
 
 var ConfigurationTableColumns = struct {
-	ID              string
-	APIKey          string
-	AbsoluteX       string
-	AbsoluteY       string
-	RefreshInterval string
-	RequestTimeout  string
-	AssetFilter     string
-	Active          string
-	Enable          string
-	ProjectIds      string
+	ID                string
+	APIKey            string
+	AbsoluteX         string
+	AbsoluteY         string
+	RefreshInterval   string
+	RequestTimeout    string
+	AssetFilter       string
+	Active            string
+	Enable            string
+	ProjectIds        string
+	PositionRetention string
+	AlarmThresholds   string
+	FirmwareVersions  string
+	LocationFilter    string
+	ProjectMappings   string
 }{
-	ID:              "configuration.id",
-	APIKey:          "configuration.api_key",
-	AbsoluteX:       "configuration.absolute_x",
-	AbsoluteY:       "configuration.absolute_y",
-	RefreshInterval: "configuration.refresh_interval",
-	RequestTimeout:  "configuration.request_timeout",
-	AssetFilter:     "configuration.asset_filter",
-	Active:          "configuration.active",
-	Enable:          "configuration.enable",
-	ProjectIds:      "configuration.project_ids",
+	ID:                "configuration.id",
+	APIKey:            "configuration.api_key",
+	AbsoluteX:         "configuration.absolute_x",
+	AbsoluteY:         "configuration.absolute_y",
+	RefreshInterval:   "configuration.refresh_interval",
+	RequestTimeout:    "configuration.request_timeout",
+	AssetFilter:       "configuration.asset_filter",
+	Active:            "configuration.active",
+	Enable:            "configuration.enable",
+	ProjectIds:        "configuration.project_ids",
+	PositionRetention: "configuration.position_retention",
+	AlarmThresholds:   "configuration.alarm_thresholds",
+	FirmwareVersions:  "configuration.firmware_versions",
+	LocationFilter:    "configuration.location_filter",
+	ProjectMappings:   "configuration.project_mappings",
 }
 
 // Generated where
 
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpernull_String struct{ field string }
 
 func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
@@ -192,29 +194,6 @@ func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelperint32 struct{ field string }
-
-func (w whereHelperint32) EQ(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint32) NEQ(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint32) LT(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint32) LTE(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint32) GT(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint32) GTE(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint32) IN(slice []int32) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint32) NIN(slice []int32) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpernull_JSON struct{ field string }
 
 func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
@@ -290,42 +269,79 @@ func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
 }
 
 var ConfigurationWhere = struct {
-	ID              whereHelperint64
-	APIKey          whereHelpernull_String
-	AbsoluteX       whereHelperfloat64
-	AbsoluteY       whereHelperfloat64
-	RefreshInterval whereHelperint32
-	RequestTimeout  whereHelperint32
-	AssetFilter     whereHelpernull_JSON
-	Active          whereHelpernull_Bool
-	Enable          whereHelpernull_Bool
-	ProjectIds      whereHelpertypes_StringArray
+	ID                whereHelperint64
+	APIKey            whereHelpernull_String
+	AbsoluteX         whereHelperfloat64
+	AbsoluteY         whereHelperfloat64
+	RefreshInterval   whereHelperint32
+	RequestTimeout    whereHelperint32
+	AssetFilter       whereHelpernull_JSON
+	Active            whereHelpernull_Bool
+	Enable            whereHelpernull_Bool
+	ProjectIds        whereHelpertypes_StringArray
+	PositionRetention whereHelperint32
+	AlarmThresholds   whereHelpernull_JSON
+	FirmwareVersions  whereHelpernull_JSON
+	LocationFilter    whereHelpernull_JSON
+	ProjectMappings   whereHelpernull_JSON
 }{
-	ID:              whereHelperint64{field: "\"kontakt_io\".\"configuration\".\"id\""},
-	APIKey:          whereHelpernull_String{field: "\"kontakt_io\".\"configuration\".\"api_key\""},
-	AbsoluteX:       whereHelperfloat64{field: "\"kontakt_io\".\"configuration\".\"absolute_x\""},
-	AbsoluteY:       whereHelperfloat64{field: "\"kontakt_io\".\"configuration\".\"absolute_y\""},
-	RefreshInterval: whereHelperint32{field: "\"kontakt_io\".\"configuration\".\"refresh_interval\""},
-	RequestTimeout:  whereHelperint32{field: "\"kontakt_io\".\"configuration\".\"request_timeout\""},
-	AssetFilter:     whereHelpernull_JSON{field: "\"kontakt_io\".\"configuration\".\"asset_filter\""},
-	Active:          whereHelpernull_Bool{field: "\"kontakt_io\".\"configuration\".\"active\""},
-	Enable:          whereHelpernull_Bool{field: "\"kontakt_io\".\"configuration\".\"enable\""},
-	ProjectIds:      whereHelpertypes_StringArray{field: "\"kontakt_io\".\"configuration\".\"project_ids\""},
+	ID:                whereHelperint64{field: "\"kontakt_io\".\"configuration\".\"id\""},
+	APIKey:            whereHelpernull_String{field: "\"kontakt_io\".\"configuration\".\"api_key\""},
+	AbsoluteX:         whereHelperfloat64{field: "\"kontakt_io\".\"configuration\".\"absolute_x\""},
+	AbsoluteY:         whereHelperfloat64{field: "\"kontakt_io\".\"configuration\".\"absolute_y\""},
+	RefreshInterval:   whereHelperint32{field: "\"kontakt_io\".\"configuration\".\"refresh_interval\""},
+	RequestTimeout:    whereHelperint32{field: "\"kontakt_io\".\"configuration\".\"request_timeout\""},
+	AssetFilter:       whereHelpernull_JSON{field: "\"kontakt_io\".\"configuration\".\"asset_filter\""},
+	Active:            whereHelpernull_Bool{field: "\"kontakt_io\".\"configuration\".\"active\""},
+	Enable:            whereHelpernull_Bool{field: "\"kontakt_io\".\"configuration\".\"enable\""},
+	ProjectIds:        whereHelpertypes_StringArray{field: "\"kontakt_io\".\"configuration\".\"project_ids\""},
+	PositionRetention: whereHelperint32{field: "\"kontakt_io\".\"configuration\".\"position_retention\""},
+	AlarmThresholds:   whereHelpernull_JSON{field: "\"kontakt_io\".\"configuration\".\"alarm_thresholds\""},
+	FirmwareVersions:  whereHelpernull_JSON{field: "\"kontakt_io\".\"configuration\".\"firmware_versions\""},
+	LocationFilter:    whereHelpernull_JSON{field: "\"kontakt_io\".\"configuration\".\"location_filter\""},
+	ProjectMappings:   whereHelpernull_JSON{field: "\"kontakt_io\".\"configuration\".\"project_mappings\""},
 }
 
 // ConfigurationRels is where relationship names are stored.
 var ConfigurationRels = struct {
-	Locations string
-	Tags      string
+	AlarmRules        string
+	BatteryLevels     string
+	DeviceInventories string
+	DeviceSettings    string
+	FloorPlans        string
+	Locations         string
+	Positions         string
+	RoomVisits        string
+	SyncRuns          string
+	Tags              string
+	Zones             string
 }{
-	Locations: "Locations",
-	Tags:      "Tags",
+	AlarmRules:        "AlarmRules",
+	BatteryLevels:     "BatteryLevels",
+	DeviceInventories: "DeviceInventories",
+	DeviceSettings:    "DeviceSettings",
+	FloorPlans:        "FloorPlans",
+	Locations:         "Locations",
+	Positions:         "Positions",
+	RoomVisits:        "RoomVisits",
+	SyncRuns:          "SyncRuns",
+	Tags:              "Tags",
+	Zones:             "Zones",
 }
 
 // configurationR is where relationships are stored.
 type configurationR struct {
-	Locations LocationSlice `boil:"Locations" json:"Locations" toml:"Locations" yaml:"Locations"`
-	Tags      TagSlice      `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
+	AlarmRules        AlarmRuleSlice       `boil:"AlarmRules" json:"AlarmRules" toml:"AlarmRules" yaml:"AlarmRules"`
+	BatteryLevels     BatteryLevelSlice    `boil:"BatteryLevels" json:"BatteryLevels" toml:"BatteryLevels" yaml:"BatteryLevels"`
+	DeviceInventories DeviceInventorySlice `boil:"DeviceInventories" json:"DeviceInventories" toml:"DeviceInventories" yaml:"DeviceInventories"`
+	DeviceSettings    DeviceSettingSlice   `boil:"DeviceSettings" json:"DeviceSettings" toml:"DeviceSettings" yaml:"DeviceSettings"`
+	FloorPlans        FloorPlanSlice       `boil:"FloorPlans" json:"FloorPlans" toml:"FloorPlans" yaml:"FloorPlans"`
+	Locations         LocationSlice        `boil:"Locations" json:"Locations" toml:"Locations" yaml:"Locations"`
+	Positions         PositionSlice        `boil:"Positions" json:"Positions" toml:"Positions" yaml:"Positions"`
+	RoomVisits        RoomVisitSlice       `boil:"RoomVisits" json:"RoomVisits" toml:"RoomVisits" yaml:"RoomVisits"`
+	SyncRuns          SyncRunSlice         `boil:"SyncRuns" json:"SyncRuns" toml:"SyncRuns" yaml:"SyncRuns"`
+	Tags              TagSlice             `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
+	Zones             ZoneSlice            `boil:"Zones" json:"Zones" toml:"Zones" yaml:"Zones"`
 }
 
 // NewStruct creates a new relationship struct
@@ -333,6 +349,41 @@ func (*configurationR) NewStruct() *configurationR {
 	return &configurationR{}
 }
 
+func (r *configurationR) GetAlarmRules() AlarmRuleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AlarmRules
+}
+
+func (r *configurationR) GetBatteryLevels() BatteryLevelSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BatteryLevels
+}
+
+func (r *configurationR) GetDeviceInventories() DeviceInventorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.DeviceInventories
+}
+
+func (r *configurationR) GetDeviceSettings() DeviceSettingSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DeviceSettings
+}
+
+func (r *configurationR) GetFloorPlans() FloorPlanSlice {
+	if r == nil {
+		return nil
+	}
+	return r.FloorPlans
+}
+
 func (r *configurationR) GetLocations() LocationSlice {
 	if r == nil {
 		return nil
@@ -340,6 +391,27 @@ func (r *configurationR) GetLocations() LocationSlice {
 	return r.Locations
 }
 
+func (r *configurationR) GetPositions() PositionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Positions
+}
+
+func (r *configurationR) GetRoomVisits() RoomVisitSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RoomVisits
+}
+
+func (r *configurationR) GetSyncRuns() SyncRunSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SyncRuns
+}
+
 func (r *configurationR) GetTags() TagSlice {
 	if r == nil {
 		return nil
@@ -347,13 +419,20 @@ func (r *configurationR) GetTags() TagSlice {
 	return r.Tags
 }
 
+func (r *configurationR) GetZones() ZoneSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Zones
+}
+
 // configurationL is where Load methods for each relationship are stored.
 type configurationL struct{}
 
 var (
-	configurationAllColumns            = []string{"id", "api_key", "absolute_x", "absolute_y", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids"}
+	configurationAllColumns            = []string{"id", "api_key", "absolute_x", "absolute_y", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids", "position_retention", "alarm_thresholds", "firmware_versions", "location_filter", "project_mappings"}
 	configurationColumnsWithoutDefault = []string{}
-	configurationColumnsWithDefault    = []string{"id", "api_key", "absolute_x", "absolute_y", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids"}
+	configurationColumnsWithDefault    = []string{"id", "api_key", "absolute_x", "absolute_y", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids", "position_retention", "alarm_thresholds", "firmware_versions", "location_filter", "project_mappings"}
 	configurationPrimaryKeyColumns     = []string{"id"}
 	configurationGeneratedColumns      = []string{}
 )
@@ -656,6 +735,76 @@ func (q configurationQuery) Exists(ctx context.Context, exec boil.ContextExecuto
 	return count > 0, nil
 }
 
+// AlarmRules retrieves all the alarm_rule's AlarmRules with an executor.
+func (o *Configuration) AlarmRules(mods ...qm.QueryMod) alarmRuleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kontakt_io\".\"alarm_rule\".\"configuration_id\"=?", o.ID),
+	)
+
+	return AlarmRules(queryMods...)
+}
+
+// BatteryLevels retrieves all the battery_level's BatteryLevels with an executor.
+func (o *Configuration) BatteryLevels(mods ...qm.QueryMod) batteryLevelQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kontakt_io\".\"battery_level\".\"configuration_id\"=?", o.ID),
+	)
+
+	return BatteryLevels(queryMods...)
+}
+
+// DeviceInventories retrieves all the device_inventory's DeviceInventories with an executor.
+func (o *Configuration) DeviceInventories(mods ...qm.QueryMod) deviceInventoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kontakt_io\".\"device_inventory\".\"configuration_id\"=?", o.ID),
+	)
+
+	return DeviceInventories(queryMods...)
+}
+
+// DeviceSettings retrieves all the device_setting's DeviceSettings with an executor.
+func (o *Configuration) DeviceSettings(mods ...qm.QueryMod) deviceSettingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kontakt_io\".\"device_setting\".\"configuration_id\"=?", o.ID),
+	)
+
+	return DeviceSettings(queryMods...)
+}
+
+// FloorPlans retrieves all the floor_plan's FloorPlans with an executor.
+func (o *Configuration) FloorPlans(mods ...qm.QueryMod) floorPlanQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kontakt_io\".\"floor_plan\".\"configuration_id\"=?", o.ID),
+	)
+
+	return FloorPlans(queryMods...)
+}
+
 // Locations retrieves all the location's Locations with an executor.
 func (o *Configuration) Locations(mods ...qm.QueryMod) locationQuery {
 	var queryMods []qm.QueryMod
@@ -670,6 +819,48 @@ func (o *Configuration) Locations(mods ...qm.QueryMod) locationQuery {
 	return Locations(queryMods...)
 }
 
+// Positions retrieves all the position's Positions with an executor.
+func (o *Configuration) Positions(mods ...qm.QueryMod) positionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kontakt_io\".\"position\".\"configuration_id\"=?", o.ID),
+	)
+
+	return Positions(queryMods...)
+}
+
+// RoomVisits retrieves all the room_visit's RoomVisits with an executor.
+func (o *Configuration) RoomVisits(mods ...qm.QueryMod) roomVisitQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kontakt_io\".\"room_visit\".\"configuration_id\"=?", o.ID),
+	)
+
+	return RoomVisits(queryMods...)
+}
+
+// SyncRuns retrieves all the sync_run's SyncRuns with an executor.
+func (o *Configuration) SyncRuns(mods ...qm.QueryMod) syncRunQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kontakt_io\".\"sync_run\".\"configuration_id\"=?", o.ID),
+	)
+
+	return SyncRuns(queryMods...)
+}
+
 // Tags retrieves all the tag's Tags with an executor.
 func (o *Configuration) Tags(mods ...qm.QueryMod) tagQuery {
 	var queryMods []qm.QueryMod
@@ -684,9 +875,23 @@ func (o *Configuration) Tags(mods ...qm.QueryMod) tagQuery {
 	return Tags(queryMods...)
 }
 
-// LoadLocations allows an eager lookup of values, cached into the
+// Zones retrieves all the zone's Zones with an executor.
+func (o *Configuration) Zones(mods ...qm.QueryMod) zoneQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kontakt_io\".\"zone\".\"configuration_id\"=?", o.ID),
+	)
+
+	return Zones(queryMods...)
+}
+
+// LoadAlarmRules allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (configurationL) LoadLocations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+func (configurationL) LoadAlarmRules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
 	var slice []*Configuration
 	var object *Configuration
 
@@ -740,8 +945,8 @@ func (configurationL) LoadLocations(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`kontakt_io.location`),
-		qm.WhereIn(`kontakt_io.location.configuration_id in ?`, args...),
+		qm.From(`kontakt_io.alarm_rule`),
+		qm.WhereIn(`kontakt_io.alarm_rule.configuration_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -749,22 +954,22 @@ func (configurationL) LoadLocations(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load location")
+		return errors.Wrap(err, "failed to eager load alarm_rule")
 	}
 
-	var resultSlice []*Location
+	var resultSlice []*AlarmRule
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice location")
+		return errors.Wrap(err, "failed to bind eager loaded slice alarm_rule")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on location")
+		return errors.Wrap(err, "failed to close results in eager load on alarm_rule")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for location")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for alarm_rule")
 	}
 
-	if len(locationAfterSelectHooks) != 0 {
+	if len(alarmRuleAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -772,10 +977,10 @@ func (configurationL) LoadLocations(ctx context.Context, e boil.ContextExecutor,
 		}
 	}
 	if singular {
-		object.R.Locations = resultSlice
+		object.R.AlarmRules = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &locationR{}
+				foreign.R = &alarmRuleR{}
 			}
 			foreign.R.Configuration = object
 		}
@@ -785,9 +990,9 @@ func (configurationL) LoadLocations(ctx context.Context, e boil.ContextExecutor,
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ConfigurationID {
-				local.R.Locations = append(local.R.Locations, foreign)
+				local.R.AlarmRules = append(local.R.AlarmRules, foreign)
 				if foreign.R == nil {
-					foreign.R = &locationR{}
+					foreign.R = &alarmRuleR{}
 				}
 				foreign.R.Configuration = local
 				break
@@ -798,9 +1003,9 @@ func (configurationL) LoadLocations(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadTags allows an eager lookup of values, cached into the
+// LoadBatteryLevels allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (configurationL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+func (configurationL) LoadBatteryLevels(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
 	var slice []*Configuration
 	var object *Configuration
 
@@ -854,8 +1059,8 @@ func (configurationL) LoadTags(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`kontakt_io.tag`),
-		qm.WhereIn(`kontakt_io.tag.configuration_id in ?`, args...),
+		qm.From(`kontakt_io.battery_level`),
+		qm.WhereIn(`kontakt_io.battery_level.configuration_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -863,22 +1068,22 @@ func (configurationL) LoadTags(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tag")
+		return errors.Wrap(err, "failed to eager load battery_level")
 	}
 
-	var resultSlice []*Tag
+	var resultSlice []*BatteryLevel
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice tag")
+		return errors.Wrap(err, "failed to bind eager loaded slice battery_level")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tag")
+		return errors.Wrap(err, "failed to close results in eager load on battery_level")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tag")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for battery_level")
 	}
 
-	if len(tagAfterSelectHooks) != 0 {
+	if len(batteryLevelAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -886,10 +1091,10 @@ func (configurationL) LoadTags(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 	if singular {
-		object.R.Tags = resultSlice
+		object.R.BatteryLevels = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tagR{}
+				foreign.R = &batteryLevelR{}
 			}
 			foreign.R.Configuration = object
 		}
@@ -899,9 +1104,9 @@ func (configurationL) LoadTags(ctx context.Context, e boil.ContextExecutor, sing
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ConfigurationID {
-				local.R.Tags = append(local.R.Tags, foreign)
+				local.R.BatteryLevels = append(local.R.BatteryLevels, foreign)
 				if foreign.R == nil {
-					foreign.R = &tagR{}
+					foreign.R = &batteryLevelR{}
 				}
 				foreign.R.Configuration = local
 				break
@@ -912,56 +1117,1392 @@ func (configurationL) LoadTags(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// AddLocationsG adds the given related objects to the existing relationships
-// of the configuration, optionally inserting them as new records.
-// Appends related to o.R.Locations.
-// Sets related.R.Configuration appropriately.
-// Uses the global database handle.
-func (o *Configuration) AddLocationsG(ctx context.Context, insert bool, related ...*Location) error {
-	return o.AddLocations(ctx, boil.GetContextDB(), insert, related...)
-}
+// LoadDeviceInventories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadDeviceInventories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
 
-// AddLocations adds the given related objects to the existing relationships
-// of the configuration, optionally inserting them as new records.
-// Appends related to o.R.Locations.
-// Sets related.R.Configuration appropriately.
-func (o *Configuration) AddLocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Location) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.ConfigurationID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
 			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
 		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"kontakt_io\".\"location\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
-				strmangle.WhereClause("\"", "\"", 2, locationPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
-
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
-			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
 			}
-
-			rel.ConfigurationID = o.ID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &configurationR{
-			Locations: related,
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		o.R.Locations = append(o.R.Locations, related...)
-	}
-
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.device_inventory`),
+		qm.WhereIn(`kontakt_io.device_inventory.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load device_inventory")
+	}
+
+	var resultSlice []*DeviceInventory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice device_inventory")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on device_inventory")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for device_inventory")
+	}
+
+	if len(deviceInventoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DeviceInventories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &deviceInventoryR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.DeviceInventories = append(local.R.DeviceInventories, foreign)
+				if foreign.R == nil {
+					foreign.R = &deviceInventoryR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDeviceSettings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadDeviceSettings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.device_setting`),
+		qm.WhereIn(`kontakt_io.device_setting.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load device_setting")
+	}
+
+	var resultSlice []*DeviceSetting
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice device_setting")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on device_setting")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for device_setting")
+	}
+
+	if len(deviceSettingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DeviceSettings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &deviceSettingR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.DeviceSettings = append(local.R.DeviceSettings, foreign)
+				if foreign.R == nil {
+					foreign.R = &deviceSettingR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFloorPlans allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadFloorPlans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.floor_plan`),
+		qm.WhereIn(`kontakt_io.floor_plan.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load floor_plan")
+	}
+
+	var resultSlice []*FloorPlan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice floor_plan")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on floor_plan")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for floor_plan")
+	}
+
+	if len(floorPlanAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FloorPlans = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &floorPlanR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.FloorPlans = append(local.R.FloorPlans, foreign)
+				if foreign.R == nil {
+					foreign.R = &floorPlanR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLocations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadLocations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.location`),
+		qm.WhereIn(`kontakt_io.location.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load location")
+	}
+
+	var resultSlice []*Location
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice location")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on location")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for location")
+	}
+
+	if len(locationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Locations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &locationR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.Locations = append(local.R.Locations, foreign)
+				if foreign.R == nil {
+					foreign.R = &locationR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPositions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadPositions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.position`),
+		qm.WhereIn(`kontakt_io.position.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load position")
+	}
+
+	var resultSlice []*Position
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice position")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on position")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for position")
+	}
+
+	if len(positionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Positions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &positionR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.Positions = append(local.R.Positions, foreign)
+				if foreign.R == nil {
+					foreign.R = &positionR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoomVisits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadRoomVisits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.room_visit`),
+		qm.WhereIn(`kontakt_io.room_visit.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load room_visit")
+	}
+
+	var resultSlice []*RoomVisit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice room_visit")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on room_visit")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for room_visit")
+	}
+
+	if len(roomVisitAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RoomVisits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &roomVisitR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.RoomVisits = append(local.R.RoomVisits, foreign)
+				if foreign.R == nil {
+					foreign.R = &roomVisitR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSyncRuns allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadSyncRuns(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.sync_run`),
+		qm.WhereIn(`kontakt_io.sync_run.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sync_run")
+	}
+
+	var resultSlice []*SyncRun
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sync_run")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sync_run")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sync_run")
+	}
+
+	if len(syncRunAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SyncRuns = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &syncRunR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.SyncRuns = append(local.R.SyncRuns, foreign)
+				if foreign.R == nil {
+					foreign.R = &syncRunR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.tag`),
+		qm.WhereIn(`kontakt_io.tag.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tag")
+	}
+
+	var resultSlice []*Tag
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tag")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tag")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tag")
+	}
+
+	if len(tagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Tags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tagR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.Tags = append(local.R.Tags, foreign)
+				if foreign.R == nil {
+					foreign.R = &tagR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadZones allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadZones(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.zone`),
+		qm.WhereIn(`kontakt_io.zone.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load zone")
+	}
+
+	var resultSlice []*Zone
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice zone")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on zone")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for zone")
+	}
+
+	if len(zoneAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Zones = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &zoneR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.Zones = append(local.R.Zones, foreign)
+				if foreign.R == nil {
+					foreign.R = &zoneR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAlarmRulesG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.AlarmRules.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddAlarmRulesG(ctx context.Context, insert bool, related ...*AlarmRule) error {
+	return o.AddAlarmRules(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAlarmRules adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.AlarmRules.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddAlarmRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AlarmRule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kontakt_io\".\"alarm_rule\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, alarmRulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.AssetID, rel.Attribute}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			AlarmRules: related,
+		}
+	} else {
+		o.R.AlarmRules = append(o.R.AlarmRules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &alarmRuleR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddBatteryLevelsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.BatteryLevels.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddBatteryLevelsG(ctx context.Context, insert bool, related ...*BatteryLevel) error {
+	return o.AddBatteryLevels(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddBatteryLevels adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.BatteryLevels.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddBatteryLevels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BatteryLevel) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kontakt_io\".\"battery_level\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, batteryLevelPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ConfigurationID, rel.DeviceID, rel.RecordedAt}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			BatteryLevels: related,
+		}
+	} else {
+		o.R.BatteryLevels = append(o.R.BatteryLevels, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &batteryLevelR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddDeviceInventoriesG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DeviceInventories.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddDeviceInventoriesG(ctx context.Context, insert bool, related ...*DeviceInventory) error {
+	return o.AddDeviceInventories(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddDeviceInventories adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DeviceInventories.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddDeviceInventories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DeviceInventory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kontakt_io\".\"device_inventory\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, deviceInventoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ConfigurationID, rel.DeviceID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			DeviceInventories: related,
+		}
+	} else {
+		o.R.DeviceInventories = append(o.R.DeviceInventories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &deviceInventoryR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddDeviceSettingsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DeviceSettings.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddDeviceSettingsG(ctx context.Context, insert bool, related ...*DeviceSetting) error {
+	return o.AddDeviceSettings(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddDeviceSettings adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DeviceSettings.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddDeviceSettings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DeviceSetting) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kontakt_io\".\"device_setting\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, deviceSettingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ConfigurationID, rel.DeviceID, rel.Attribute}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			DeviceSettings: related,
+		}
+	} else {
+		o.R.DeviceSettings = append(o.R.DeviceSettings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &deviceSettingR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddFloorPlansG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.FloorPlans.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddFloorPlansG(ctx context.Context, insert bool, related ...*FloorPlan) error {
+	return o.AddFloorPlans(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddFloorPlans adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.FloorPlans.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddFloorPlans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FloorPlan) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kontakt_io\".\"floor_plan\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, floorPlanPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.LocationID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			FloorPlans: related,
+		}
+	} else {
+		o.R.FloorPlans = append(o.R.FloorPlans, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &floorPlanR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddLocationsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.Locations.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddLocationsG(ctx context.Context, insert bool, related ...*Location) error {
+	return o.AddLocations(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddLocations adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.Locations.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddLocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Location) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kontakt_io\".\"location\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, locationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			Locations: related,
+		}
+	} else {
+		o.R.Locations = append(o.R.Locations, related...)
+	}
+
 	for _, rel := range related {
 		if rel.R == nil {
 			rel.R = &locationR{
@@ -974,6 +2515,192 @@ func (o *Configuration) AddLocations(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
+// AddPositionsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.Positions.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddPositionsG(ctx context.Context, insert bool, related ...*Position) error {
+	return o.AddPositions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddPositions adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.Positions.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddPositions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Position) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kontakt_io\".\"position\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, positionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ConfigurationID, rel.DeviceID, rel.RecordedAt}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			Positions: related,
+		}
+	} else {
+		o.R.Positions = append(o.R.Positions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &positionR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddRoomVisitsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.RoomVisits.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddRoomVisitsG(ctx context.Context, insert bool, related ...*RoomVisit) error {
+	return o.AddRoomVisits(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddRoomVisits adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.RoomVisits.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddRoomVisits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RoomVisit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kontakt_io\".\"room_visit\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, roomVisitPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			RoomVisits: related,
+		}
+	} else {
+		o.R.RoomVisits = append(o.R.RoomVisits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &roomVisitR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddSyncRunsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.SyncRuns.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddSyncRunsG(ctx context.Context, insert bool, related ...*SyncRun) error {
+	return o.AddSyncRuns(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddSyncRuns adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.SyncRuns.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddSyncRuns(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SyncRun) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kontakt_io\".\"sync_run\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, syncRunPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			SyncRuns: related,
+		}
+	} else {
+		o.R.SyncRuns = append(o.R.SyncRuns, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &syncRunR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
 // AddTagsG adds the given related objects to the existing relationships
 // of the configuration, optionally inserting them as new records.
 // Appends related to o.R.Tags.
@@ -1036,6 +2763,68 @@ func (o *Configuration) AddTags(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddZonesG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.Zones.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddZonesG(ctx context.Context, insert bool, related ...*Zone) error {
+	return o.AddZones(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddZones adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.Zones.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddZones(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Zone) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kontakt_io\".\"zone\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, zonePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			Zones: related,
+		}
+	} else {
+		o.R.Zones = append(o.R.Zones, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &zoneR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
 // Configurations retrieves all the records using an executor.
 func Configurations(mods ...qm.QueryMod) configurationQuery {
 	mods = append(mods, qm.From("\"kontakt_io\".\"configuration\""))
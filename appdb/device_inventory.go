@@ -0,0 +1,1283 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DeviceInventory is an object representing the database table.
+type DeviceInventory struct {
+	ConfigurationID int64       `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	DeviceID        string      `boil:"device_id" json:"device_id" toml:"device_id" yaml:"device_id"`
+	Name            string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Product         string      `boil:"product" json:"product" toml:"product" yaml:"product"`
+	AssetType       null.String `boil:"asset_type" json:"asset_type,omitempty" toml:"asset_type" yaml:"asset_type,omitempty"`
+	Firmware        null.String `boil:"firmware" json:"firmware,omitempty" toml:"firmware" yaml:"firmware,omitempty"`
+	MatchesFilter   bool        `boil:"matches_filter" json:"matches_filter" toml:"matches_filter" yaml:"matches_filter"`
+	LastSeenAt      null.Time   `boil:"last_seen_at" json:"last_seen_at,omitempty" toml:"last_seen_at" yaml:"last_seen_at,omitempty"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *deviceInventoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L deviceInventoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DeviceInventoryColumns = struct {
+	ConfigurationID string
+	DeviceID        string
+	Name            string
+	Product         string
+	AssetType       string
+	Firmware        string
+	MatchesFilter   string
+	LastSeenAt      string
+	UpdatedAt       string
+}{
+	ConfigurationID: "configuration_id",
+	DeviceID:        "device_id",
+	Name:            "name",
+	Product:         "product",
+	AssetType:       "asset_type",
+	Firmware:        "firmware",
+	MatchesFilter:   "matches_filter",
+	LastSeenAt:      "last_seen_at",
+	UpdatedAt:       "updated_at",
+}
+
+var DeviceInventoryTableColumns = struct {
+	ConfigurationID string
+	DeviceID        string
+	Name            string
+	Product         string
+	AssetType       string
+	Firmware        string
+	MatchesFilter   string
+	LastSeenAt      string
+	UpdatedAt       string
+}{
+	ConfigurationID: "device_inventory.configuration_id",
+	DeviceID:        "device_inventory.device_id",
+	Name:            "device_inventory.name",
+	Product:         "device_inventory.product",
+	AssetType:       "device_inventory.asset_type",
+	Firmware:        "device_inventory.firmware",
+	MatchesFilter:   "device_inventory.matches_filter",
+	LastSeenAt:      "device_inventory.last_seen_at",
+	UpdatedAt:       "device_inventory.updated_at",
+}
+
+// Generated where
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var DeviceInventoryWhere = struct {
+	ConfigurationID whereHelperint64
+	DeviceID        whereHelperstring
+	Name            whereHelperstring
+	Product         whereHelperstring
+	AssetType       whereHelpernull_String
+	Firmware        whereHelpernull_String
+	MatchesFilter   whereHelperbool
+	LastSeenAt      whereHelpernull_Time
+	UpdatedAt       whereHelpertime_Time
+}{
+	ConfigurationID: whereHelperint64{field: "\"kontakt_io\".\"device_inventory\".\"configuration_id\""},
+	DeviceID:        whereHelperstring{field: "\"kontakt_io\".\"device_inventory\".\"device_id\""},
+	Name:            whereHelperstring{field: "\"kontakt_io\".\"device_inventory\".\"name\""},
+	Product:         whereHelperstring{field: "\"kontakt_io\".\"device_inventory\".\"product\""},
+	AssetType:       whereHelpernull_String{field: "\"kontakt_io\".\"device_inventory\".\"asset_type\""},
+	Firmware:        whereHelpernull_String{field: "\"kontakt_io\".\"device_inventory\".\"firmware\""},
+	MatchesFilter:   whereHelperbool{field: "\"kontakt_io\".\"device_inventory\".\"matches_filter\""},
+	LastSeenAt:      whereHelpernull_Time{field: "\"kontakt_io\".\"device_inventory\".\"last_seen_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"kontakt_io\".\"device_inventory\".\"updated_at\""},
+}
+
+// DeviceInventoryRels is where relationship names are stored.
+var DeviceInventoryRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// deviceInventoryR is where relationships are stored.
+type deviceInventoryR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*deviceInventoryR) NewStruct() *deviceInventoryR {
+	return &deviceInventoryR{}
+}
+
+func (r *deviceInventoryR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// deviceInventoryL is where Load methods for each relationship are stored.
+type deviceInventoryL struct{}
+
+var (
+	deviceInventoryAllColumns            = []string{"configuration_id", "device_id", "name", "product", "asset_type", "firmware", "matches_filter", "last_seen_at", "updated_at"}
+	deviceInventoryColumnsWithoutDefault = []string{"configuration_id", "device_id", "name", "product", "updated_at"}
+	deviceInventoryColumnsWithDefault    = []string{"asset_type", "firmware", "matches_filter", "last_seen_at"}
+	deviceInventoryPrimaryKeyColumns     = []string{"configuration_id", "device_id"}
+	deviceInventoryGeneratedColumns      = []string{}
+)
+
+type (
+	// DeviceInventorySlice is an alias for a slice of pointers to DeviceInventory.
+	// This should almost always be used instead of []DeviceInventory.
+	DeviceInventorySlice []*DeviceInventory
+	// DeviceInventoryHook is the signature for custom DeviceInventory hook methods
+	DeviceInventoryHook func(context.Context, boil.ContextExecutor, *DeviceInventory) error
+
+	deviceInventoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	deviceInventoryType                 = reflect.TypeOf(&DeviceInventory{})
+	deviceInventoryMapping              = queries.MakeStructMapping(deviceInventoryType)
+	deviceInventoryPrimaryKeyMapping, _ = queries.BindMapping(deviceInventoryType, deviceInventoryMapping, deviceInventoryPrimaryKeyColumns)
+	deviceInventoryInsertCacheMut       sync.RWMutex
+	deviceInventoryInsertCache          = make(map[string]insertCache)
+	deviceInventoryUpdateCacheMut       sync.RWMutex
+	deviceInventoryUpdateCache          = make(map[string]updateCache)
+	deviceInventoryUpsertCacheMut       sync.RWMutex
+	deviceInventoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var deviceInventoryAfterSelectHooks []DeviceInventoryHook
+
+var deviceInventoryBeforeInsertHooks []DeviceInventoryHook
+var deviceInventoryAfterInsertHooks []DeviceInventoryHook
+
+var deviceInventoryBeforeUpdateHooks []DeviceInventoryHook
+var deviceInventoryAfterUpdateHooks []DeviceInventoryHook
+
+var deviceInventoryBeforeDeleteHooks []DeviceInventoryHook
+var deviceInventoryAfterDeleteHooks []DeviceInventoryHook
+
+var deviceInventoryBeforeUpsertHooks []DeviceInventoryHook
+var deviceInventoryAfterUpsertHooks []DeviceInventoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DeviceInventory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceInventoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DeviceInventory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceInventoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DeviceInventory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceInventoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DeviceInventory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceInventoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DeviceInventory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceInventoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DeviceInventory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceInventoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DeviceInventory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceInventoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DeviceInventory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceInventoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DeviceInventory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceInventoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDeviceInventoryHook registers your hook function for all future operations.
+func AddDeviceInventoryHook(hookPoint boil.HookPoint, deviceInventoryHook DeviceInventoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		deviceInventoryAfterSelectHooks = append(deviceInventoryAfterSelectHooks, deviceInventoryHook)
+	case boil.BeforeInsertHook:
+		deviceInventoryBeforeInsertHooks = append(deviceInventoryBeforeInsertHooks, deviceInventoryHook)
+	case boil.AfterInsertHook:
+		deviceInventoryAfterInsertHooks = append(deviceInventoryAfterInsertHooks, deviceInventoryHook)
+	case boil.BeforeUpdateHook:
+		deviceInventoryBeforeUpdateHooks = append(deviceInventoryBeforeUpdateHooks, deviceInventoryHook)
+	case boil.AfterUpdateHook:
+		deviceInventoryAfterUpdateHooks = append(deviceInventoryAfterUpdateHooks, deviceInventoryHook)
+	case boil.BeforeDeleteHook:
+		deviceInventoryBeforeDeleteHooks = append(deviceInventoryBeforeDeleteHooks, deviceInventoryHook)
+	case boil.AfterDeleteHook:
+		deviceInventoryAfterDeleteHooks = append(deviceInventoryAfterDeleteHooks, deviceInventoryHook)
+	case boil.BeforeUpsertHook:
+		deviceInventoryBeforeUpsertHooks = append(deviceInventoryBeforeUpsertHooks, deviceInventoryHook)
+	case boil.AfterUpsertHook:
+		deviceInventoryAfterUpsertHooks = append(deviceInventoryAfterUpsertHooks, deviceInventoryHook)
+	}
+}
+
+// OneG returns a single deviceInventory record from the query using the global executor.
+func (q deviceInventoryQuery) OneG(ctx context.Context) (*DeviceInventory, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single deviceInventory record from the query.
+func (q deviceInventoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DeviceInventory, error) {
+	o := &DeviceInventory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for device_inventory")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all DeviceInventory records from the query using the global executor.
+func (q deviceInventoryQuery) AllG(ctx context.Context) (DeviceInventorySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all DeviceInventory records from the query.
+func (q deviceInventoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (DeviceInventorySlice, error) {
+	var o []*DeviceInventory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to DeviceInventory slice")
+	}
+
+	if len(deviceInventoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all DeviceInventory records in the query using the global executor
+func (q deviceInventoryQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all DeviceInventory records in the query.
+func (q deviceInventoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count device_inventory rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q deviceInventoryQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q deviceInventoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if device_inventory exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *DeviceInventory) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (deviceInventoryL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeviceInventory interface{}, mods queries.Applicator) error {
+	var slice []*DeviceInventory
+	var object *DeviceInventory
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeviceInventory.(*DeviceInventory)
+		if !ok {
+			object = new(DeviceInventory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeviceInventory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeviceInventory))
+			}
+		}
+	} else {
+		s, ok := maybeDeviceInventory.(*[]*DeviceInventory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeviceInventory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeviceInventory))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &deviceInventoryR{}
+		}
+		args = append(args, object.ConfigurationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &deviceInventoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ConfigurationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ConfigurationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.configuration`),
+		qm.WhereIn(`kontakt_io.configuration.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.DeviceInventories = append(foreign.R.DeviceInventories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.DeviceInventories = append(foreign.R.DeviceInventories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the deviceInventory to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.DeviceInventories.
+// Uses the global database handle.
+func (o *DeviceInventory) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the deviceInventory to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.DeviceInventories.
+func (o *DeviceInventory) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kontakt_io\".\"device_inventory\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, deviceInventoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConfigurationID, o.DeviceID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &deviceInventoryR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			DeviceInventories: DeviceInventorySlice{o},
+		}
+	} else {
+		related.R.DeviceInventories = append(related.R.DeviceInventories, o)
+	}
+
+	return nil
+}
+
+// DeviceInventories retrieves all the records using an executor.
+func DeviceInventories(mods ...qm.QueryMod) deviceInventoryQuery {
+	mods = append(mods, qm.From("\"kontakt_io\".\"device_inventory\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kontakt_io\".\"device_inventory\".*"})
+	}
+
+	return deviceInventoryQuery{q}
+}
+
+// FindDeviceInventoryG retrieves a single record by ID.
+func FindDeviceInventoryG(ctx context.Context, configurationID int64, deviceID string, selectCols ...string) (*DeviceInventory, error) {
+	return FindDeviceInventory(ctx, boil.GetContextDB(), configurationID, deviceID, selectCols...)
+}
+
+// FindDeviceInventory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDeviceInventory(ctx context.Context, exec boil.ContextExecutor, configurationID int64, deviceID string, selectCols ...string) (*DeviceInventory, error) {
+	deviceInventoryObj := &DeviceInventory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kontakt_io\".\"device_inventory\" where \"configuration_id\"=$1 AND \"device_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, configurationID, deviceID)
+
+	err := q.Bind(ctx, exec, deviceInventoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from device_inventory")
+	}
+
+	if err = deviceInventoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return deviceInventoryObj, err
+	}
+
+	return deviceInventoryObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *DeviceInventory) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DeviceInventory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no device_inventory provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deviceInventoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	deviceInventoryInsertCacheMut.RLock()
+	cache, cached := deviceInventoryInsertCache[key]
+	deviceInventoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			deviceInventoryAllColumns,
+			deviceInventoryColumnsWithDefault,
+			deviceInventoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(deviceInventoryType, deviceInventoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(deviceInventoryType, deviceInventoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kontakt_io\".\"device_inventory\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kontakt_io\".\"device_inventory\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into device_inventory")
+	}
+
+	if !cached {
+		deviceInventoryInsertCacheMut.Lock()
+		deviceInventoryInsertCache[key] = cache
+		deviceInventoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single DeviceInventory record using the global executor.
+// See Update for more documentation.
+func (o *DeviceInventory) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the DeviceInventory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DeviceInventory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	deviceInventoryUpdateCacheMut.RLock()
+	cache, cached := deviceInventoryUpdateCache[key]
+	deviceInventoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			deviceInventoryAllColumns,
+			deviceInventoryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update device_inventory, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kontakt_io\".\"device_inventory\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, deviceInventoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(deviceInventoryType, deviceInventoryMapping, append(wl, deviceInventoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update device_inventory row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for device_inventory")
+	}
+
+	if !cached {
+		deviceInventoryUpdateCacheMut.Lock()
+		deviceInventoryUpdateCache[key] = cache
+		deviceInventoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q deviceInventoryQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q deviceInventoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for device_inventory")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for device_inventory")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o DeviceInventorySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DeviceInventorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deviceInventoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kontakt_io\".\"device_inventory\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, deviceInventoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in deviceInventory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all deviceInventory")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *DeviceInventory) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DeviceInventory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no device_inventory provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deviceInventoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	deviceInventoryUpsertCacheMut.RLock()
+	cache, cached := deviceInventoryUpsertCache[key]
+	deviceInventoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			deviceInventoryAllColumns,
+			deviceInventoryColumnsWithDefault,
+			deviceInventoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			deviceInventoryAllColumns,
+			deviceInventoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert device_inventory, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(deviceInventoryPrimaryKeyColumns))
+			copy(conflict, deviceInventoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kontakt_io\".\"device_inventory\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(deviceInventoryType, deviceInventoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(deviceInventoryType, deviceInventoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert device_inventory")
+	}
+
+	if !cached {
+		deviceInventoryUpsertCacheMut.Lock()
+		deviceInventoryUpsertCache[key] = cache
+		deviceInventoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single DeviceInventory record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *DeviceInventory) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single DeviceInventory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DeviceInventory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no DeviceInventory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), deviceInventoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"kontakt_io\".\"device_inventory\" WHERE \"configuration_id\"=$1 AND \"device_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from device_inventory")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for device_inventory")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q deviceInventoryQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q deviceInventoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no deviceInventoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from device_inventory")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for device_inventory")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o DeviceInventorySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DeviceInventorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(deviceInventoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deviceInventoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kontakt_io\".\"device_inventory\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deviceInventoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from deviceInventory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for device_inventory")
+	}
+
+	if len(deviceInventoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *DeviceInventory) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no DeviceInventory provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DeviceInventory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDeviceInventory(ctx, exec, o.ConfigurationID, o.DeviceID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DeviceInventorySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty DeviceInventorySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DeviceInventorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DeviceInventorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deviceInventoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kontakt_io\".\"device_inventory\".* FROM \"kontakt_io\".\"device_inventory\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deviceInventoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in DeviceInventorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DeviceInventoryExistsG checks if the DeviceInventory row exists.
+func DeviceInventoryExistsG(ctx context.Context, configurationID int64, deviceID string) (bool, error) {
+	return DeviceInventoryExists(ctx, boil.GetContextDB(), configurationID, deviceID)
+}
+
+// DeviceInventoryExists checks if the DeviceInventory row exists.
+func DeviceInventoryExists(ctx context.Context, exec boil.ContextExecutor, configurationID int64, deviceID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kontakt_io\".\"device_inventory\" where \"configuration_id\"=$1 AND \"device_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, configurationID, deviceID)
+	}
+	row := exec.QueryRowContext(ctx, sql, configurationID, deviceID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if device_inventory exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DeviceInventory row exists.
+func (o *DeviceInventory) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DeviceInventoryExists(ctx, exec, o.ConfigurationID, o.DeviceID)
+}
@@ -0,0 +1,1232 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DeviceSetting is an object representing the database table.
+type DeviceSetting struct {
+	ConfigurationID int64       `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	DeviceID        string      `boil:"device_id" json:"device_id" toml:"device_id" yaml:"device_id"`
+	Attribute       string      `boil:"attribute" json:"attribute" toml:"attribute" yaml:"attribute"`
+	Value           string      `boil:"value" json:"value" toml:"value" yaml:"value"`
+	State           string      `boil:"state" json:"state" toml:"state" yaml:"state"`
+	Error           null.String `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	RequestedAt     time.Time   `boil:"requested_at" json:"requested_at" toml:"requested_at" yaml:"requested_at"`
+	PushedAt        null.Time   `boil:"pushed_at" json:"pushed_at,omitempty" toml:"pushed_at" yaml:"pushed_at,omitempty"`
+	AppliedAt       null.Time   `boil:"applied_at" json:"applied_at,omitempty" toml:"applied_at" yaml:"applied_at,omitempty"`
+
+	R *deviceSettingR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L deviceSettingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DeviceSettingColumns = struct {
+	ConfigurationID string
+	DeviceID        string
+	Attribute       string
+	Value           string
+	State           string
+	Error           string
+	RequestedAt     string
+	PushedAt        string
+	AppliedAt       string
+}{
+	ConfigurationID: "configuration_id",
+	DeviceID:        "device_id",
+	Attribute:       "attribute",
+	Value:           "value",
+	State:           "state",
+	Error:           "error",
+	RequestedAt:     "requested_at",
+	PushedAt:        "pushed_at",
+	AppliedAt:       "applied_at",
+}
+
+var DeviceSettingTableColumns = struct {
+	ConfigurationID string
+	DeviceID        string
+	Attribute       string
+	Value           string
+	State           string
+	Error           string
+	RequestedAt     string
+	PushedAt        string
+	AppliedAt       string
+}{
+	ConfigurationID: "device_setting.configuration_id",
+	DeviceID:        "device_setting.device_id",
+	Attribute:       "device_setting.attribute",
+	Value:           "device_setting.value",
+	State:           "device_setting.state",
+	Error:           "device_setting.error",
+	RequestedAt:     "device_setting.requested_at",
+	PushedAt:        "device_setting.pushed_at",
+	AppliedAt:       "device_setting.applied_at",
+}
+
+// Generated where
+
+var DeviceSettingWhere = struct {
+	ConfigurationID whereHelperint64
+	DeviceID        whereHelperstring
+	Attribute       whereHelperstring
+	Value           whereHelperstring
+	State           whereHelperstring
+	Error           whereHelpernull_String
+	RequestedAt     whereHelpertime_Time
+	PushedAt        whereHelpernull_Time
+	AppliedAt       whereHelpernull_Time
+}{
+	ConfigurationID: whereHelperint64{field: "\"kontakt_io\".\"device_setting\".\"configuration_id\""},
+	DeviceID:        whereHelperstring{field: "\"kontakt_io\".\"device_setting\".\"device_id\""},
+	Attribute:       whereHelperstring{field: "\"kontakt_io\".\"device_setting\".\"attribute\""},
+	Value:           whereHelperstring{field: "\"kontakt_io\".\"device_setting\".\"value\""},
+	State:           whereHelperstring{field: "\"kontakt_io\".\"device_setting\".\"state\""},
+	Error:           whereHelpernull_String{field: "\"kontakt_io\".\"device_setting\".\"error\""},
+	RequestedAt:     whereHelpertime_Time{field: "\"kontakt_io\".\"device_setting\".\"requested_at\""},
+	PushedAt:        whereHelpernull_Time{field: "\"kontakt_io\".\"device_setting\".\"pushed_at\""},
+	AppliedAt:       whereHelpernull_Time{field: "\"kontakt_io\".\"device_setting\".\"applied_at\""},
+}
+
+// DeviceSettingRels is where relationship names are stored.
+var DeviceSettingRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// deviceSettingR is where relationships are stored.
+type deviceSettingR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*deviceSettingR) NewStruct() *deviceSettingR {
+	return &deviceSettingR{}
+}
+
+func (r *deviceSettingR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// deviceSettingL is where Load methods for each relationship are stored.
+type deviceSettingL struct{}
+
+var (
+	deviceSettingAllColumns            = []string{"configuration_id", "device_id", "attribute", "value", "state", "error", "requested_at", "pushed_at", "applied_at"}
+	deviceSettingColumnsWithoutDefault = []string{"configuration_id", "device_id", "attribute", "value", "state", "requested_at"}
+	deviceSettingColumnsWithDefault    = []string{"error", "pushed_at", "applied_at"}
+	deviceSettingPrimaryKeyColumns     = []string{"configuration_id", "device_id", "attribute"}
+	deviceSettingGeneratedColumns      = []string{}
+)
+
+type (
+	// DeviceSettingSlice is an alias for a slice of pointers to DeviceSetting.
+	// This should almost always be used instead of []DeviceSetting.
+	DeviceSettingSlice []*DeviceSetting
+	// DeviceSettingHook is the signature for custom DeviceSetting hook methods
+	DeviceSettingHook func(context.Context, boil.ContextExecutor, *DeviceSetting) error
+
+	deviceSettingQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	deviceSettingType                 = reflect.TypeOf(&DeviceSetting{})
+	deviceSettingMapping              = queries.MakeStructMapping(deviceSettingType)
+	deviceSettingPrimaryKeyMapping, _ = queries.BindMapping(deviceSettingType, deviceSettingMapping, deviceSettingPrimaryKeyColumns)
+	deviceSettingInsertCacheMut       sync.RWMutex
+	deviceSettingInsertCache          = make(map[string]insertCache)
+	deviceSettingUpdateCacheMut       sync.RWMutex
+	deviceSettingUpdateCache          = make(map[string]updateCache)
+	deviceSettingUpsertCacheMut       sync.RWMutex
+	deviceSettingUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var deviceSettingAfterSelectHooks []DeviceSettingHook
+
+var deviceSettingBeforeInsertHooks []DeviceSettingHook
+var deviceSettingAfterInsertHooks []DeviceSettingHook
+
+var deviceSettingBeforeUpdateHooks []DeviceSettingHook
+var deviceSettingAfterUpdateHooks []DeviceSettingHook
+
+var deviceSettingBeforeDeleteHooks []DeviceSettingHook
+var deviceSettingAfterDeleteHooks []DeviceSettingHook
+
+var deviceSettingBeforeUpsertHooks []DeviceSettingHook
+var deviceSettingAfterUpsertHooks []DeviceSettingHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DeviceSetting) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceSettingAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DeviceSetting) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceSettingBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DeviceSetting) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceSettingAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DeviceSetting) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceSettingBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DeviceSetting) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceSettingAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DeviceSetting) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceSettingBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DeviceSetting) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceSettingAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DeviceSetting) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceSettingBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DeviceSetting) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceSettingAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDeviceSettingHook registers your hook function for all future operations.
+func AddDeviceSettingHook(hookPoint boil.HookPoint, deviceSettingHook DeviceSettingHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		deviceSettingAfterSelectHooks = append(deviceSettingAfterSelectHooks, deviceSettingHook)
+	case boil.BeforeInsertHook:
+		deviceSettingBeforeInsertHooks = append(deviceSettingBeforeInsertHooks, deviceSettingHook)
+	case boil.AfterInsertHook:
+		deviceSettingAfterInsertHooks = append(deviceSettingAfterInsertHooks, deviceSettingHook)
+	case boil.BeforeUpdateHook:
+		deviceSettingBeforeUpdateHooks = append(deviceSettingBeforeUpdateHooks, deviceSettingHook)
+	case boil.AfterUpdateHook:
+		deviceSettingAfterUpdateHooks = append(deviceSettingAfterUpdateHooks, deviceSettingHook)
+	case boil.BeforeDeleteHook:
+		deviceSettingBeforeDeleteHooks = append(deviceSettingBeforeDeleteHooks, deviceSettingHook)
+	case boil.AfterDeleteHook:
+		deviceSettingAfterDeleteHooks = append(deviceSettingAfterDeleteHooks, deviceSettingHook)
+	case boil.BeforeUpsertHook:
+		deviceSettingBeforeUpsertHooks = append(deviceSettingBeforeUpsertHooks, deviceSettingHook)
+	case boil.AfterUpsertHook:
+		deviceSettingAfterUpsertHooks = append(deviceSettingAfterUpsertHooks, deviceSettingHook)
+	}
+}
+
+// OneG returns a single deviceSetting record from the query using the global executor.
+func (q deviceSettingQuery) OneG(ctx context.Context) (*DeviceSetting, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single deviceSetting record from the query.
+func (q deviceSettingQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DeviceSetting, error) {
+	o := &DeviceSetting{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for device_setting")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all DeviceSetting records from the query using the global executor.
+func (q deviceSettingQuery) AllG(ctx context.Context) (DeviceSettingSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all DeviceSetting records from the query.
+func (q deviceSettingQuery) All(ctx context.Context, exec boil.ContextExecutor) (DeviceSettingSlice, error) {
+	var o []*DeviceSetting
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to DeviceSetting slice")
+	}
+
+	if len(deviceSettingAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all DeviceSetting records in the query using the global executor
+func (q deviceSettingQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all DeviceSetting records in the query.
+func (q deviceSettingQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count device_setting rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q deviceSettingQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q deviceSettingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if device_setting exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *DeviceSetting) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (deviceSettingL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeviceSetting interface{}, mods queries.Applicator) error {
+	var slice []*DeviceSetting
+	var object *DeviceSetting
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeviceSetting.(*DeviceSetting)
+		if !ok {
+			object = new(DeviceSetting)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeviceSetting)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeviceSetting))
+			}
+		}
+	} else {
+		s, ok := maybeDeviceSetting.(*[]*DeviceSetting)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeviceSetting)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeviceSetting))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &deviceSettingR{}
+		}
+		args = append(args, object.ConfigurationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &deviceSettingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ConfigurationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ConfigurationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.configuration`),
+		qm.WhereIn(`kontakt_io.configuration.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.DeviceSettings = append(foreign.R.DeviceSettings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.DeviceSettings = append(foreign.R.DeviceSettings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the deviceSetting to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.DeviceSettings.
+// Uses the global database handle.
+func (o *DeviceSetting) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the deviceSetting to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.DeviceSettings.
+func (o *DeviceSetting) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kontakt_io\".\"device_setting\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, deviceSettingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConfigurationID, o.DeviceID, o.Attribute}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &deviceSettingR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			DeviceSettings: DeviceSettingSlice{o},
+		}
+	} else {
+		related.R.DeviceSettings = append(related.R.DeviceSettings, o)
+	}
+
+	return nil
+}
+
+// DeviceSettings retrieves all the records using an executor.
+func DeviceSettings(mods ...qm.QueryMod) deviceSettingQuery {
+	mods = append(mods, qm.From("\"kontakt_io\".\"device_setting\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kontakt_io\".\"device_setting\".*"})
+	}
+
+	return deviceSettingQuery{q}
+}
+
+// FindDeviceSettingG retrieves a single record by ID.
+func FindDeviceSettingG(ctx context.Context, configurationID int64, deviceID string, attribute string, selectCols ...string) (*DeviceSetting, error) {
+	return FindDeviceSetting(ctx, boil.GetContextDB(), configurationID, deviceID, attribute, selectCols...)
+}
+
+// FindDeviceSetting retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDeviceSetting(ctx context.Context, exec boil.ContextExecutor, configurationID int64, deviceID string, attribute string, selectCols ...string) (*DeviceSetting, error) {
+	deviceSettingObj := &DeviceSetting{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kontakt_io\".\"device_setting\" where \"configuration_id\"=$1 AND \"device_id\"=$2 AND \"attribute\"=$3", sel,
+	)
+
+	q := queries.Raw(query, configurationID, deviceID, attribute)
+
+	err := q.Bind(ctx, exec, deviceSettingObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from device_setting")
+	}
+
+	if err = deviceSettingObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return deviceSettingObj, err
+	}
+
+	return deviceSettingObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *DeviceSetting) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DeviceSetting) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no device_setting provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deviceSettingColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	deviceSettingInsertCacheMut.RLock()
+	cache, cached := deviceSettingInsertCache[key]
+	deviceSettingInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			deviceSettingAllColumns,
+			deviceSettingColumnsWithDefault,
+			deviceSettingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(deviceSettingType, deviceSettingMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(deviceSettingType, deviceSettingMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kontakt_io\".\"device_setting\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kontakt_io\".\"device_setting\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into device_setting")
+	}
+
+	if !cached {
+		deviceSettingInsertCacheMut.Lock()
+		deviceSettingInsertCache[key] = cache
+		deviceSettingInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single DeviceSetting record using the global executor.
+// See Update for more documentation.
+func (o *DeviceSetting) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the DeviceSetting.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DeviceSetting) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	deviceSettingUpdateCacheMut.RLock()
+	cache, cached := deviceSettingUpdateCache[key]
+	deviceSettingUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			deviceSettingAllColumns,
+			deviceSettingPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update device_setting, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kontakt_io\".\"device_setting\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, deviceSettingPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(deviceSettingType, deviceSettingMapping, append(wl, deviceSettingPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update device_setting row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for device_setting")
+	}
+
+	if !cached {
+		deviceSettingUpdateCacheMut.Lock()
+		deviceSettingUpdateCache[key] = cache
+		deviceSettingUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q deviceSettingQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q deviceSettingQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for device_setting")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for device_setting")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o DeviceSettingSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DeviceSettingSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deviceSettingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kontakt_io\".\"device_setting\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, deviceSettingPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in deviceSetting slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all deviceSetting")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *DeviceSetting) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DeviceSetting) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no device_setting provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deviceSettingColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	deviceSettingUpsertCacheMut.RLock()
+	cache, cached := deviceSettingUpsertCache[key]
+	deviceSettingUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			deviceSettingAllColumns,
+			deviceSettingColumnsWithDefault,
+			deviceSettingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			deviceSettingAllColumns,
+			deviceSettingPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert device_setting, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(deviceSettingPrimaryKeyColumns))
+			copy(conflict, deviceSettingPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kontakt_io\".\"device_setting\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(deviceSettingType, deviceSettingMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(deviceSettingType, deviceSettingMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert device_setting")
+	}
+
+	if !cached {
+		deviceSettingUpsertCacheMut.Lock()
+		deviceSettingUpsertCache[key] = cache
+		deviceSettingUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single DeviceSetting record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *DeviceSetting) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single DeviceSetting record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DeviceSetting) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no DeviceSetting provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), deviceSettingPrimaryKeyMapping)
+	sql := "DELETE FROM \"kontakt_io\".\"device_setting\" WHERE \"configuration_id\"=$1 AND \"device_id\"=$2 AND \"attribute\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from device_setting")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for device_setting")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q deviceSettingQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q deviceSettingQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no deviceSettingQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from device_setting")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for device_setting")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o DeviceSettingSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DeviceSettingSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(deviceSettingBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deviceSettingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kontakt_io\".\"device_setting\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deviceSettingPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from deviceSetting slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for device_setting")
+	}
+
+	if len(deviceSettingAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *DeviceSetting) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no DeviceSetting provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DeviceSetting) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDeviceSetting(ctx, exec, o.ConfigurationID, o.DeviceID, o.Attribute)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DeviceSettingSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty DeviceSettingSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DeviceSettingSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DeviceSettingSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deviceSettingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kontakt_io\".\"device_setting\".* FROM \"kontakt_io\".\"device_setting\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deviceSettingPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in DeviceSettingSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DeviceSettingExistsG checks if the DeviceSetting row exists.
+func DeviceSettingExistsG(ctx context.Context, configurationID int64, deviceID string, attribute string) (bool, error) {
+	return DeviceSettingExists(ctx, boil.GetContextDB(), configurationID, deviceID, attribute)
+}
+
+// DeviceSettingExists checks if the DeviceSetting row exists.
+func DeviceSettingExists(ctx context.Context, exec boil.ContextExecutor, configurationID int64, deviceID string, attribute string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kontakt_io\".\"device_setting\" where \"configuration_id\"=$1 AND \"device_id\"=$2 AND \"attribute\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, configurationID, deviceID, attribute)
+	}
+	row := exec.QueryRowContext(ctx, sql, configurationID, deviceID, attribute)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if device_setting exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DeviceSetting row exists.
+func (o *DeviceSetting) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DeviceSettingExists(ctx, exec, o.ConfigurationID, o.DeviceID, o.Attribute)
+}
@@ -0,0 +1,1498 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// FloorPlan is an object representing the database table.
+type FloorPlan struct {
+	LocationID      int64       `boil:"location_id" json:"location_id" toml:"location_id" yaml:"location_id"`
+	ConfigurationID int64       `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	FloorID         int32       `boil:"floor_id" json:"floor_id" toml:"floor_id" yaml:"floor_id"`
+	ImageURL        null.String `boil:"image_url" json:"image_url,omitempty" toml:"image_url" yaml:"image_url,omitempty"`
+	Image           null.Bytes  `boil:"image" json:"image,omitempty" toml:"image" yaml:"image,omitempty"`
+	ContentType     null.String `boil:"content_type" json:"content_type,omitempty" toml:"content_type" yaml:"content_type,omitempty"`
+	ImageWidth      int32       `boil:"image_width" json:"image_width" toml:"image_width" yaml:"image_width"`
+	ImageHeight     int32       `boil:"image_height" json:"image_height" toml:"image_height" yaml:"image_height"`
+	Scale           float64     `boil:"scale" json:"scale" toml:"scale" yaml:"scale"`
+	OriginX         float64     `boil:"origin_x" json:"origin_x" toml:"origin_x" yaml:"origin_x"`
+	OriginY         float64     `boil:"origin_y" json:"origin_y" toml:"origin_y" yaml:"origin_y"`
+	Rotation        float64     `boil:"rotation" json:"rotation" toml:"rotation" yaml:"rotation"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *floorPlanR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L floorPlanL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FloorPlanColumns = struct {
+	LocationID      string
+	ConfigurationID string
+	FloorID         string
+	ImageURL        string
+	Image           string
+	ContentType     string
+	ImageWidth      string
+	ImageHeight     string
+	Scale           string
+	OriginX         string
+	OriginY         string
+	Rotation        string
+	UpdatedAt       string
+}{
+	LocationID:      "location_id",
+	ConfigurationID: "configuration_id",
+	FloorID:         "floor_id",
+	ImageURL:        "image_url",
+	Image:           "image",
+	ContentType:     "content_type",
+	ImageWidth:      "image_width",
+	ImageHeight:     "image_height",
+	Scale:           "scale",
+	OriginX:         "origin_x",
+	OriginY:         "origin_y",
+	Rotation:        "rotation",
+	UpdatedAt:       "updated_at",
+}
+
+var FloorPlanTableColumns = struct {
+	LocationID      string
+	ConfigurationID string
+	FloorID         string
+	ImageURL        string
+	Image           string
+	ContentType     string
+	ImageWidth      string
+	ImageHeight     string
+	Scale           string
+	OriginX         string
+	OriginY         string
+	Rotation        string
+	UpdatedAt       string
+}{
+	LocationID:      "floor_plan.location_id",
+	ConfigurationID: "floor_plan.configuration_id",
+	FloorID:         "floor_plan.floor_id",
+	ImageURL:        "floor_plan.image_url",
+	Image:           "floor_plan.image",
+	ContentType:     "floor_plan.content_type",
+	ImageWidth:      "floor_plan.image_width",
+	ImageHeight:     "floor_plan.image_height",
+	Scale:           "floor_plan.scale",
+	OriginX:         "floor_plan.origin_x",
+	OriginY:         "floor_plan.origin_y",
+	Rotation:        "floor_plan.rotation",
+	UpdatedAt:       "floor_plan.updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_Bytes struct{ field string }
+
+func (w whereHelpernull_Bytes) EQ(x null.Bytes) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Bytes) NEQ(x null.Bytes) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Bytes) LT(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Bytes) LTE(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Bytes) GT(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Bytes) GTE(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Bytes) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Bytes) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var FloorPlanWhere = struct {
+	LocationID      whereHelperint64
+	ConfigurationID whereHelperint64
+	FloorID         whereHelperint32
+	ImageURL        whereHelpernull_String
+	Image           whereHelpernull_Bytes
+	ContentType     whereHelpernull_String
+	ImageWidth      whereHelperint32
+	ImageHeight     whereHelperint32
+	Scale           whereHelperfloat64
+	OriginX         whereHelperfloat64
+	OriginY         whereHelperfloat64
+	Rotation        whereHelperfloat64
+	UpdatedAt       whereHelpertime_Time
+}{
+	LocationID:      whereHelperint64{field: "\"kontakt_io\".\"floor_plan\".\"location_id\""},
+	ConfigurationID: whereHelperint64{field: "\"kontakt_io\".\"floor_plan\".\"configuration_id\""},
+	FloorID:         whereHelperint32{field: "\"kontakt_io\".\"floor_plan\".\"floor_id\""},
+	ImageURL:        whereHelpernull_String{field: "\"kontakt_io\".\"floor_plan\".\"image_url\""},
+	Image:           whereHelpernull_Bytes{field: "\"kontakt_io\".\"floor_plan\".\"image\""},
+	ContentType:     whereHelpernull_String{field: "\"kontakt_io\".\"floor_plan\".\"content_type\""},
+	ImageWidth:      whereHelperint32{field: "\"kontakt_io\".\"floor_plan\".\"image_width\""},
+	ImageHeight:     whereHelperint32{field: "\"kontakt_io\".\"floor_plan\".\"image_height\""},
+	Scale:           whereHelperfloat64{field: "\"kontakt_io\".\"floor_plan\".\"scale\""},
+	OriginX:         whereHelperfloat64{field: "\"kontakt_io\".\"floor_plan\".\"origin_x\""},
+	OriginY:         whereHelperfloat64{field: "\"kontakt_io\".\"floor_plan\".\"origin_y\""},
+	Rotation:        whereHelperfloat64{field: "\"kontakt_io\".\"floor_plan\".\"rotation\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"kontakt_io\".\"floor_plan\".\"updated_at\""},
+}
+
+// FloorPlanRels is where relationship names are stored.
+var FloorPlanRels = struct {
+	Configuration string
+	Location      string
+}{
+	Configuration: "Configuration",
+	Location:      "Location",
+}
+
+// floorPlanR is where relationships are stored.
+type floorPlanR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+	Location      *Location      `boil:"Location" json:"Location" toml:"Location" yaml:"Location"`
+}
+
+// NewStruct creates a new relationship struct
+func (*floorPlanR) NewStruct() *floorPlanR {
+	return &floorPlanR{}
+}
+
+func (r *floorPlanR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+func (r *floorPlanR) GetLocation() *Location {
+	if r == nil {
+		return nil
+	}
+	return r.Location
+}
+
+// floorPlanL is where Load methods for each relationship are stored.
+type floorPlanL struct{}
+
+var (
+	floorPlanAllColumns            = []string{"location_id", "configuration_id", "floor_id", "image_url", "image", "content_type", "image_width", "image_height", "scale", "origin_x", "origin_y", "rotation", "updated_at"}
+	floorPlanColumnsWithoutDefault = []string{"location_id", "configuration_id", "floor_id", "updated_at"}
+	floorPlanColumnsWithDefault    = []string{"image_url", "image", "content_type", "image_width", "image_height", "scale", "origin_x", "origin_y", "rotation"}
+	floorPlanPrimaryKeyColumns     = []string{"location_id"}
+	floorPlanGeneratedColumns      = []string{}
+)
+
+type (
+	// FloorPlanSlice is an alias for a slice of pointers to FloorPlan.
+	// This should almost always be used instead of []FloorPlan.
+	FloorPlanSlice []*FloorPlan
+	// FloorPlanHook is the signature for custom FloorPlan hook methods
+	FloorPlanHook func(context.Context, boil.ContextExecutor, *FloorPlan) error
+
+	floorPlanQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	floorPlanType                 = reflect.TypeOf(&FloorPlan{})
+	floorPlanMapping              = queries.MakeStructMapping(floorPlanType)
+	floorPlanPrimaryKeyMapping, _ = queries.BindMapping(floorPlanType, floorPlanMapping, floorPlanPrimaryKeyColumns)
+	floorPlanInsertCacheMut       sync.RWMutex
+	floorPlanInsertCache          = make(map[string]insertCache)
+	floorPlanUpdateCacheMut       sync.RWMutex
+	floorPlanUpdateCache          = make(map[string]updateCache)
+	floorPlanUpsertCacheMut       sync.RWMutex
+	floorPlanUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var floorPlanAfterSelectHooks []FloorPlanHook
+
+var floorPlanBeforeInsertHooks []FloorPlanHook
+var floorPlanAfterInsertHooks []FloorPlanHook
+
+var floorPlanBeforeUpdateHooks []FloorPlanHook
+var floorPlanAfterUpdateHooks []FloorPlanHook
+
+var floorPlanBeforeDeleteHooks []FloorPlanHook
+var floorPlanAfterDeleteHooks []FloorPlanHook
+
+var floorPlanBeforeUpsertHooks []FloorPlanHook
+var floorPlanAfterUpsertHooks []FloorPlanHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FloorPlan) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range floorPlanAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FloorPlan) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range floorPlanBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FloorPlan) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range floorPlanAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FloorPlan) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range floorPlanBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FloorPlan) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range floorPlanAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FloorPlan) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range floorPlanBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FloorPlan) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range floorPlanAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FloorPlan) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range floorPlanBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FloorPlan) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range floorPlanAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFloorPlanHook registers your hook function for all future operations.
+func AddFloorPlanHook(hookPoint boil.HookPoint, floorPlanHook FloorPlanHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		floorPlanAfterSelectHooks = append(floorPlanAfterSelectHooks, floorPlanHook)
+	case boil.BeforeInsertHook:
+		floorPlanBeforeInsertHooks = append(floorPlanBeforeInsertHooks, floorPlanHook)
+	case boil.AfterInsertHook:
+		floorPlanAfterInsertHooks = append(floorPlanAfterInsertHooks, floorPlanHook)
+	case boil.BeforeUpdateHook:
+		floorPlanBeforeUpdateHooks = append(floorPlanBeforeUpdateHooks, floorPlanHook)
+	case boil.AfterUpdateHook:
+		floorPlanAfterUpdateHooks = append(floorPlanAfterUpdateHooks, floorPlanHook)
+	case boil.BeforeDeleteHook:
+		floorPlanBeforeDeleteHooks = append(floorPlanBeforeDeleteHooks, floorPlanHook)
+	case boil.AfterDeleteHook:
+		floorPlanAfterDeleteHooks = append(floorPlanAfterDeleteHooks, floorPlanHook)
+	case boil.BeforeUpsertHook:
+		floorPlanBeforeUpsertHooks = append(floorPlanBeforeUpsertHooks, floorPlanHook)
+	case boil.AfterUpsertHook:
+		floorPlanAfterUpsertHooks = append(floorPlanAfterUpsertHooks, floorPlanHook)
+	}
+}
+
+// OneG returns a single floorPlan record from the query using the global executor.
+func (q floorPlanQuery) OneG(ctx context.Context) (*FloorPlan, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single floorPlan record from the query.
+func (q floorPlanQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FloorPlan, error) {
+	o := &FloorPlan{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for floor_plan")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all FloorPlan records from the query using the global executor.
+func (q floorPlanQuery) AllG(ctx context.Context) (FloorPlanSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all FloorPlan records from the query.
+func (q floorPlanQuery) All(ctx context.Context, exec boil.ContextExecutor) (FloorPlanSlice, error) {
+	var o []*FloorPlan
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to FloorPlan slice")
+	}
+
+	if len(floorPlanAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all FloorPlan records in the query using the global executor
+func (q floorPlanQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all FloorPlan records in the query.
+func (q floorPlanQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count floor_plan rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q floorPlanQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q floorPlanQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if floor_plan exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *FloorPlan) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// Location pointed to by the foreign key.
+func (o *FloorPlan) Location(mods ...qm.QueryMod) locationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LocationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Locations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (floorPlanL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFloorPlan interface{}, mods queries.Applicator) error {
+	var slice []*FloorPlan
+	var object *FloorPlan
+
+	if singular {
+		var ok bool
+		object, ok = maybeFloorPlan.(*FloorPlan)
+		if !ok {
+			object = new(FloorPlan)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFloorPlan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFloorPlan))
+			}
+		}
+	} else {
+		s, ok := maybeFloorPlan.(*[]*FloorPlan)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFloorPlan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFloorPlan))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &floorPlanR{}
+		}
+		args = append(args, object.ConfigurationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &floorPlanR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ConfigurationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ConfigurationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.configuration`),
+		qm.WhereIn(`kontakt_io.configuration.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.FloorPlans = append(foreign.R.FloorPlans, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.FloorPlans = append(foreign.R.FloorPlans, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLocation allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (floorPlanL) LoadLocation(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFloorPlan interface{}, mods queries.Applicator) error {
+	var slice []*FloorPlan
+	var object *FloorPlan
+
+	if singular {
+		var ok bool
+		object, ok = maybeFloorPlan.(*FloorPlan)
+		if !ok {
+			object = new(FloorPlan)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFloorPlan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFloorPlan))
+			}
+		}
+	} else {
+		s, ok := maybeFloorPlan.(*[]*FloorPlan)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFloorPlan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFloorPlan))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &floorPlanR{}
+		}
+		args = append(args, object.LocationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &floorPlanR{}
+			}
+
+			for _, a := range args {
+				if a == obj.LocationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.LocationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.location`),
+		qm.WhereIn(`kontakt_io.location.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Location")
+	}
+
+	var resultSlice []*Location
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Location")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for location")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for location")
+	}
+
+	if len(locationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Location = foreign
+		if foreign.R == nil {
+			foreign.R = &locationR{}
+		}
+		foreign.R.FloorPlan = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.LocationID == foreign.ID {
+				local.R.Location = foreign
+				if foreign.R == nil {
+					foreign.R = &locationR{}
+				}
+				foreign.R.FloorPlan = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the floorPlan to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.FloorPlans.
+// Uses the global database handle.
+func (o *FloorPlan) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the floorPlan to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.FloorPlans.
+func (o *FloorPlan) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kontakt_io\".\"floor_plan\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, floorPlanPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.LocationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &floorPlanR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			FloorPlans: FloorPlanSlice{o},
+		}
+	} else {
+		related.R.FloorPlans = append(related.R.FloorPlans, o)
+	}
+
+	return nil
+}
+
+// SetLocationG of the floorPlan to the related item.
+// Sets o.R.Location to related.
+// Adds o to related.R.FloorPlan.
+// Uses the global database handle.
+func (o *FloorPlan) SetLocationG(ctx context.Context, insert bool, related *Location) error {
+	return o.SetLocation(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetLocation of the floorPlan to the related item.
+// Sets o.R.Location to related.
+// Adds o to related.R.FloorPlan.
+func (o *FloorPlan) SetLocation(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Location) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kontakt_io\".\"floor_plan\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"location_id"}),
+		strmangle.WhereClause("\"", "\"", 2, floorPlanPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.LocationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.LocationID = related.ID
+	if o.R == nil {
+		o.R = &floorPlanR{
+			Location: related,
+		}
+	} else {
+		o.R.Location = related
+	}
+
+	if related.R == nil {
+		related.R = &locationR{
+			FloorPlan: o,
+		}
+	} else {
+		related.R.FloorPlan = o
+	}
+
+	return nil
+}
+
+// FloorPlans retrieves all the records using an executor.
+func FloorPlans(mods ...qm.QueryMod) floorPlanQuery {
+	mods = append(mods, qm.From("\"kontakt_io\".\"floor_plan\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kontakt_io\".\"floor_plan\".*"})
+	}
+
+	return floorPlanQuery{q}
+}
+
+// FindFloorPlanG retrieves a single record by ID.
+func FindFloorPlanG(ctx context.Context, locationID int64, selectCols ...string) (*FloorPlan, error) {
+	return FindFloorPlan(ctx, boil.GetContextDB(), locationID, selectCols...)
+}
+
+// FindFloorPlan retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFloorPlan(ctx context.Context, exec boil.ContextExecutor, locationID int64, selectCols ...string) (*FloorPlan, error) {
+	floorPlanObj := &FloorPlan{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kontakt_io\".\"floor_plan\" where \"location_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, locationID)
+
+	err := q.Bind(ctx, exec, floorPlanObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from floor_plan")
+	}
+
+	if err = floorPlanObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return floorPlanObj, err
+	}
+
+	return floorPlanObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *FloorPlan) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FloorPlan) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no floor_plan provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(floorPlanColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	floorPlanInsertCacheMut.RLock()
+	cache, cached := floorPlanInsertCache[key]
+	floorPlanInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			floorPlanAllColumns,
+			floorPlanColumnsWithDefault,
+			floorPlanColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(floorPlanType, floorPlanMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(floorPlanType, floorPlanMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kontakt_io\".\"floor_plan\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kontakt_io\".\"floor_plan\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into floor_plan")
+	}
+
+	if !cached {
+		floorPlanInsertCacheMut.Lock()
+		floorPlanInsertCache[key] = cache
+		floorPlanInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single FloorPlan record using the global executor.
+// See Update for more documentation.
+func (o *FloorPlan) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the FloorPlan.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FloorPlan) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	floorPlanUpdateCacheMut.RLock()
+	cache, cached := floorPlanUpdateCache[key]
+	floorPlanUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			floorPlanAllColumns,
+			floorPlanPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update floor_plan, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kontakt_io\".\"floor_plan\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, floorPlanPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(floorPlanType, floorPlanMapping, append(wl, floorPlanPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update floor_plan row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for floor_plan")
+	}
+
+	if !cached {
+		floorPlanUpdateCacheMut.Lock()
+		floorPlanUpdateCache[key] = cache
+		floorPlanUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q floorPlanQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q floorPlanQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for floor_plan")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for floor_plan")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o FloorPlanSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FloorPlanSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), floorPlanPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kontakt_io\".\"floor_plan\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, floorPlanPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in floorPlan slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all floorPlan")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *FloorPlan) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FloorPlan) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no floor_plan provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(floorPlanColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	floorPlanUpsertCacheMut.RLock()
+	cache, cached := floorPlanUpsertCache[key]
+	floorPlanUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			floorPlanAllColumns,
+			floorPlanColumnsWithDefault,
+			floorPlanColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			floorPlanAllColumns,
+			floorPlanPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert floor_plan, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(floorPlanPrimaryKeyColumns))
+			copy(conflict, floorPlanPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kontakt_io\".\"floor_plan\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(floorPlanType, floorPlanMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(floorPlanType, floorPlanMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert floor_plan")
+	}
+
+	if !cached {
+		floorPlanUpsertCacheMut.Lock()
+		floorPlanUpsertCache[key] = cache
+		floorPlanUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single FloorPlan record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *FloorPlan) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single FloorPlan record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FloorPlan) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no FloorPlan provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), floorPlanPrimaryKeyMapping)
+	sql := "DELETE FROM \"kontakt_io\".\"floor_plan\" WHERE \"location_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from floor_plan")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for floor_plan")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q floorPlanQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q floorPlanQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no floorPlanQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from floor_plan")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for floor_plan")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o FloorPlanSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FloorPlanSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(floorPlanBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), floorPlanPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kontakt_io\".\"floor_plan\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, floorPlanPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from floorPlan slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for floor_plan")
+	}
+
+	if len(floorPlanAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *FloorPlan) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no FloorPlan provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FloorPlan) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFloorPlan(ctx, exec, o.LocationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FloorPlanSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty FloorPlanSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FloorPlanSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FloorPlanSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), floorPlanPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kontakt_io\".\"floor_plan\".* FROM \"kontakt_io\".\"floor_plan\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, floorPlanPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in FloorPlanSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FloorPlanExistsG checks if the FloorPlan row exists.
+func FloorPlanExistsG(ctx context.Context, locationID int64) (bool, error) {
+	return FloorPlanExists(ctx, boil.GetContextDB(), locationID)
+}
+
+// FloorPlanExists checks if the FloorPlan row exists.
+func FloorPlanExists(ctx context.Context, exec boil.ContextExecutor, locationID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kontakt_io\".\"floor_plan\" where \"location_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, locationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, locationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if floor_plan exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the FloorPlan row exists.
+func (o *FloorPlan) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return FloorPlanExists(ctx, exec, o.LocationID)
+}
@@ -79,71 +79,6 @@ var LocationTableColumns = struct {
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpernull_Float64 struct{ field string }
-
-func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_Float64) IN(slice []float64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_Float64) NIN(slice []float64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 type whereHelpernull_Int32 struct{ field string }
 
 func (w whereHelpernull_Int32) EQ(x null.Int32) qm.QueryMod {
@@ -206,10 +141,12 @@ var LocationWhere = struct {
 var LocationRels = struct {
 	Configuration   string
 	Parent          string
+	FloorPlan       string
 	ParentLocations string
 }{
 	Configuration:   "Configuration",
 	Parent:          "Parent",
+	FloorPlan:       "FloorPlan",
 	ParentLocations: "ParentLocations",
 }
 
@@ -217,6 +154,7 @@ var LocationRels = struct {
 type locationR struct {
 	Configuration   *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
 	Parent          *Location      `boil:"Parent" json:"Parent" toml:"Parent" yaml:"Parent"`
+	FloorPlan       *FloorPlan     `boil:"FloorPlan" json:"FloorPlan" toml:"FloorPlan" yaml:"FloorPlan"`
 	ParentLocations LocationSlice  `boil:"ParentLocations" json:"ParentLocations" toml:"ParentLocations" yaml:"ParentLocations"`
 }
 
@@ -239,6 +177,13 @@ func (r *locationR) GetParent() *Location {
 	return r.Parent
 }
 
+func (r *locationR) GetFloorPlan() *FloorPlan {
+	if r == nil {
+		return nil
+	}
+	return r.FloorPlan
+}
+
 func (r *locationR) GetParentLocations() LocationSlice {
 	if r == nil {
 		return nil
@@ -577,6 +522,17 @@ func (o *Location) Parent(mods ...qm.QueryMod) locationQuery {
 	return Locations(queryMods...)
 }
 
+// FloorPlan pointed to by the foreign key.
+func (o *Location) FloorPlan(mods ...qm.QueryMod) floorPlanQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"location_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return FloorPlans(queryMods...)
+}
+
 // ParentLocations retrieves all the location's Locations with an executor via parent_id column.
 func (o *Location) ParentLocations(mods ...qm.QueryMod) locationQuery {
 	var queryMods []qm.QueryMod
@@ -831,6 +787,123 @@ func (locationL) LoadParent(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
+// LoadFloorPlan allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (locationL) LoadFloorPlan(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLocation interface{}, mods queries.Applicator) error {
+	var slice []*Location
+	var object *Location
+
+	if singular {
+		var ok bool
+		object, ok = maybeLocation.(*Location)
+		if !ok {
+			object = new(Location)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLocation))
+			}
+		}
+	} else {
+		s, ok := maybeLocation.(*[]*Location)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLocation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &locationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &locationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.floor_plan`),
+		qm.WhereIn(`kontakt_io.floor_plan.location_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load FloorPlan")
+	}
+
+	var resultSlice []*FloorPlan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice FloorPlan")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for floor_plan")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for floor_plan")
+	}
+
+	if len(floorPlanAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.FloorPlan = foreign
+		if foreign.R == nil {
+			foreign.R = &floorPlanR{}
+		}
+		foreign.R.Location = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.LocationID {
+				local.R.FloorPlan = foreign
+				if foreign.R == nil {
+					foreign.R = &floorPlanR{}
+				}
+				foreign.R.Location = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadParentLocations allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (locationL) LoadParentLocations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLocation interface{}, mods queries.Applicator) error {
@@ -1055,6 +1128,64 @@ func (o *Location) SetParent(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
+// SetFloorPlanG of the location to the related item.
+// Sets o.R.FloorPlan to related.
+// Adds o to related.R.Location.
+// Uses the global database handle.
+func (o *Location) SetFloorPlanG(ctx context.Context, insert bool, related *FloorPlan) error {
+	return o.SetFloorPlan(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetFloorPlan of the location to the related item.
+// Sets o.R.FloorPlan to related.
+// Adds o to related.R.Location.
+func (o *Location) SetFloorPlan(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FloorPlan) error {
+	var err error
+
+	if insert {
+		related.LocationID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"kontakt_io\".\"floor_plan\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"location_id"}),
+			strmangle.WhereClause("\"", "\"", 2, floorPlanPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.LocationID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.LocationID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &locationR{
+			FloorPlan: related,
+		}
+	} else {
+		o.R.FloorPlan = related
+	}
+
+	if related.R == nil {
+		related.R = &floorPlanR{
+			Location: o,
+		}
+	} else {
+		related.R.Location = o
+	}
+	return nil
+}
+
 // AddParentLocationsG adds the given related objects to the existing relationships
 // of the location, optionally inserting them as new records.
 // Appends related to o.R.ParentLocations.
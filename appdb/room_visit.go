@@ -0,0 +1,1225 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RoomVisit is an object representing the database table.
+type RoomVisit struct {
+	ID              int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64     `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	DeviceID        string    `boil:"device_id" json:"device_id" toml:"device_id" yaml:"device_id"`
+	RoomID          int32     `boil:"room_id" json:"room_id" toml:"room_id" yaml:"room_id"`
+	EnteredAt       time.Time `boil:"entered_at" json:"entered_at" toml:"entered_at" yaml:"entered_at"`
+	LastSeenAt      time.Time `boil:"last_seen_at" json:"last_seen_at" toml:"last_seen_at" yaml:"last_seen_at"`
+	ExitedAt        null.Time `boil:"exited_at" json:"exited_at,omitempty" toml:"exited_at" yaml:"exited_at,omitempty"`
+	Duration        int32     `boil:"duration" json:"duration" toml:"duration" yaml:"duration"`
+
+	R *roomVisitR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L roomVisitL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RoomVisitColumns = struct {
+	ID              string
+	ConfigurationID string
+	DeviceID        string
+	RoomID          string
+	EnteredAt       string
+	LastSeenAt      string
+	ExitedAt        string
+	Duration        string
+}{
+	ID:              "id",
+	ConfigurationID: "configuration_id",
+	DeviceID:        "device_id",
+	RoomID:          "room_id",
+	EnteredAt:       "entered_at",
+	LastSeenAt:      "last_seen_at",
+	ExitedAt:        "exited_at",
+	Duration:        "duration",
+}
+
+var RoomVisitTableColumns = struct {
+	ID              string
+	ConfigurationID string
+	DeviceID        string
+	RoomID          string
+	EnteredAt       string
+	LastSeenAt      string
+	ExitedAt        string
+	Duration        string
+}{
+	ID:              "room_visit.id",
+	ConfigurationID: "room_visit.configuration_id",
+	DeviceID:        "room_visit.device_id",
+	RoomID:          "room_visit.room_id",
+	EnteredAt:       "room_visit.entered_at",
+	LastSeenAt:      "room_visit.last_seen_at",
+	ExitedAt:        "room_visit.exited_at",
+	Duration:        "room_visit.duration",
+}
+
+// Generated where
+
+var RoomVisitWhere = struct {
+	ID              whereHelperint64
+	ConfigurationID whereHelperint64
+	DeviceID        whereHelperstring
+	RoomID          whereHelperint32
+	EnteredAt       whereHelpertime_Time
+	LastSeenAt      whereHelpertime_Time
+	ExitedAt        whereHelpernull_Time
+	Duration        whereHelperint32
+}{
+	ID:              whereHelperint64{field: "\"kontakt_io\".\"room_visit\".\"id\""},
+	ConfigurationID: whereHelperint64{field: "\"kontakt_io\".\"room_visit\".\"configuration_id\""},
+	DeviceID:        whereHelperstring{field: "\"kontakt_io\".\"room_visit\".\"device_id\""},
+	RoomID:          whereHelperint32{field: "\"kontakt_io\".\"room_visit\".\"room_id\""},
+	EnteredAt:       whereHelpertime_Time{field: "\"kontakt_io\".\"room_visit\".\"entered_at\""},
+	LastSeenAt:      whereHelpertime_Time{field: "\"kontakt_io\".\"room_visit\".\"last_seen_at\""},
+	ExitedAt:        whereHelpernull_Time{field: "\"kontakt_io\".\"room_visit\".\"exited_at\""},
+	Duration:        whereHelperint32{field: "\"kontakt_io\".\"room_visit\".\"duration\""},
+}
+
+// RoomVisitRels is where relationship names are stored.
+var RoomVisitRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// roomVisitR is where relationships are stored.
+type roomVisitR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*roomVisitR) NewStruct() *roomVisitR {
+	return &roomVisitR{}
+}
+
+func (r *roomVisitR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// roomVisitL is where Load methods for each relationship are stored.
+type roomVisitL struct{}
+
+var (
+	roomVisitAllColumns            = []string{"id", "configuration_id", "device_id", "room_id", "entered_at", "last_seen_at", "exited_at", "duration"}
+	roomVisitColumnsWithoutDefault = []string{"configuration_id", "device_id", "room_id", "entered_at", "last_seen_at"}
+	roomVisitColumnsWithDefault    = []string{"id", "exited_at", "duration"}
+	roomVisitPrimaryKeyColumns     = []string{"id"}
+	roomVisitGeneratedColumns      = []string{}
+)
+
+type (
+	// RoomVisitSlice is an alias for a slice of pointers to RoomVisit.
+	// This should almost always be used instead of []RoomVisit.
+	RoomVisitSlice []*RoomVisit
+	// RoomVisitHook is the signature for custom RoomVisit hook methods
+	RoomVisitHook func(context.Context, boil.ContextExecutor, *RoomVisit) error
+
+	roomVisitQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	roomVisitType                 = reflect.TypeOf(&RoomVisit{})
+	roomVisitMapping              = queries.MakeStructMapping(roomVisitType)
+	roomVisitPrimaryKeyMapping, _ = queries.BindMapping(roomVisitType, roomVisitMapping, roomVisitPrimaryKeyColumns)
+	roomVisitInsertCacheMut       sync.RWMutex
+	roomVisitInsertCache          = make(map[string]insertCache)
+	roomVisitUpdateCacheMut       sync.RWMutex
+	roomVisitUpdateCache          = make(map[string]updateCache)
+	roomVisitUpsertCacheMut       sync.RWMutex
+	roomVisitUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var roomVisitAfterSelectHooks []RoomVisitHook
+
+var roomVisitBeforeInsertHooks []RoomVisitHook
+var roomVisitAfterInsertHooks []RoomVisitHook
+
+var roomVisitBeforeUpdateHooks []RoomVisitHook
+var roomVisitAfterUpdateHooks []RoomVisitHook
+
+var roomVisitBeforeDeleteHooks []RoomVisitHook
+var roomVisitAfterDeleteHooks []RoomVisitHook
+
+var roomVisitBeforeUpsertHooks []RoomVisitHook
+var roomVisitAfterUpsertHooks []RoomVisitHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RoomVisit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomVisitAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RoomVisit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomVisitBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RoomVisit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomVisitAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RoomVisit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomVisitBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RoomVisit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomVisitAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RoomVisit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomVisitBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RoomVisit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomVisitAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RoomVisit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomVisitBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RoomVisit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomVisitAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRoomVisitHook registers your hook function for all future operations.
+func AddRoomVisitHook(hookPoint boil.HookPoint, roomVisitHook RoomVisitHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		roomVisitAfterSelectHooks = append(roomVisitAfterSelectHooks, roomVisitHook)
+	case boil.BeforeInsertHook:
+		roomVisitBeforeInsertHooks = append(roomVisitBeforeInsertHooks, roomVisitHook)
+	case boil.AfterInsertHook:
+		roomVisitAfterInsertHooks = append(roomVisitAfterInsertHooks, roomVisitHook)
+	case boil.BeforeUpdateHook:
+		roomVisitBeforeUpdateHooks = append(roomVisitBeforeUpdateHooks, roomVisitHook)
+	case boil.AfterUpdateHook:
+		roomVisitAfterUpdateHooks = append(roomVisitAfterUpdateHooks, roomVisitHook)
+	case boil.BeforeDeleteHook:
+		roomVisitBeforeDeleteHooks = append(roomVisitBeforeDeleteHooks, roomVisitHook)
+	case boil.AfterDeleteHook:
+		roomVisitAfterDeleteHooks = append(roomVisitAfterDeleteHooks, roomVisitHook)
+	case boil.BeforeUpsertHook:
+		roomVisitBeforeUpsertHooks = append(roomVisitBeforeUpsertHooks, roomVisitHook)
+	case boil.AfterUpsertHook:
+		roomVisitAfterUpsertHooks = append(roomVisitAfterUpsertHooks, roomVisitHook)
+	}
+}
+
+// OneG returns a single roomVisit record from the query using the global executor.
+func (q roomVisitQuery) OneG(ctx context.Context) (*RoomVisit, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single roomVisit record from the query.
+func (q roomVisitQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RoomVisit, error) {
+	o := &RoomVisit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for room_visit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all RoomVisit records from the query using the global executor.
+func (q roomVisitQuery) AllG(ctx context.Context) (RoomVisitSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all RoomVisit records from the query.
+func (q roomVisitQuery) All(ctx context.Context, exec boil.ContextExecutor) (RoomVisitSlice, error) {
+	var o []*RoomVisit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to RoomVisit slice")
+	}
+
+	if len(roomVisitAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all RoomVisit records in the query using the global executor
+func (q roomVisitQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all RoomVisit records in the query.
+func (q roomVisitQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count room_visit rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q roomVisitQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q roomVisitQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if room_visit exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *RoomVisit) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (roomVisitL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRoomVisit interface{}, mods queries.Applicator) error {
+	var slice []*RoomVisit
+	var object *RoomVisit
+
+	if singular {
+		var ok bool
+		object, ok = maybeRoomVisit.(*RoomVisit)
+		if !ok {
+			object = new(RoomVisit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRoomVisit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRoomVisit))
+			}
+		}
+	} else {
+		s, ok := maybeRoomVisit.(*[]*RoomVisit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRoomVisit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRoomVisit))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &roomVisitR{}
+		}
+		args = append(args, object.ConfigurationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roomVisitR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ConfigurationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ConfigurationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.configuration`),
+		qm.WhereIn(`kontakt_io.configuration.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.RoomVisits = append(foreign.R.RoomVisits, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.RoomVisits = append(foreign.R.RoomVisits, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the roomVisit to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.RoomVisits.
+// Uses the global database handle.
+func (o *RoomVisit) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the roomVisit to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.RoomVisits.
+func (o *RoomVisit) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kontakt_io\".\"room_visit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, roomVisitPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &roomVisitR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			RoomVisits: RoomVisitSlice{o},
+		}
+	} else {
+		related.R.RoomVisits = append(related.R.RoomVisits, o)
+	}
+
+	return nil
+}
+
+// RoomVisits retrieves all the records using an executor.
+func RoomVisits(mods ...qm.QueryMod) roomVisitQuery {
+	mods = append(mods, qm.From("\"kontakt_io\".\"room_visit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kontakt_io\".\"room_visit\".*"})
+	}
+
+	return roomVisitQuery{q}
+}
+
+// FindRoomVisitG retrieves a single record by ID.
+func FindRoomVisitG(ctx context.Context, iD int64, selectCols ...string) (*RoomVisit, error) {
+	return FindRoomVisit(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindRoomVisit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRoomVisit(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*RoomVisit, error) {
+	roomVisitObj := &RoomVisit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kontakt_io\".\"room_visit\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, roomVisitObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from room_visit")
+	}
+
+	if err = roomVisitObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return roomVisitObj, err
+	}
+
+	return roomVisitObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *RoomVisit) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RoomVisit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no room_visit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(roomVisitColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	roomVisitInsertCacheMut.RLock()
+	cache, cached := roomVisitInsertCache[key]
+	roomVisitInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			roomVisitAllColumns,
+			roomVisitColumnsWithDefault,
+			roomVisitColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(roomVisitType, roomVisitMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(roomVisitType, roomVisitMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kontakt_io\".\"room_visit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kontakt_io\".\"room_visit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into room_visit")
+	}
+
+	if !cached {
+		roomVisitInsertCacheMut.Lock()
+		roomVisitInsertCache[key] = cache
+		roomVisitInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single RoomVisit record using the global executor.
+// See Update for more documentation.
+func (o *RoomVisit) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the RoomVisit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RoomVisit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	roomVisitUpdateCacheMut.RLock()
+	cache, cached := roomVisitUpdateCache[key]
+	roomVisitUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			roomVisitAllColumns,
+			roomVisitPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update room_visit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kontakt_io\".\"room_visit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, roomVisitPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(roomVisitType, roomVisitMapping, append(wl, roomVisitPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update room_visit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for room_visit")
+	}
+
+	if !cached {
+		roomVisitUpdateCacheMut.Lock()
+		roomVisitUpdateCache[key] = cache
+		roomVisitUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q roomVisitQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q roomVisitQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for room_visit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for room_visit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o RoomVisitSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RoomVisitSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), roomVisitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kontakt_io\".\"room_visit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, roomVisitPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in roomVisit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all roomVisit")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *RoomVisit) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RoomVisit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no room_visit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(roomVisitColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	roomVisitUpsertCacheMut.RLock()
+	cache, cached := roomVisitUpsertCache[key]
+	roomVisitUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			roomVisitAllColumns,
+			roomVisitColumnsWithDefault,
+			roomVisitColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			roomVisitAllColumns,
+			roomVisitPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert room_visit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(roomVisitPrimaryKeyColumns))
+			copy(conflict, roomVisitPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kontakt_io\".\"room_visit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(roomVisitType, roomVisitMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(roomVisitType, roomVisitMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert room_visit")
+	}
+
+	if !cached {
+		roomVisitUpsertCacheMut.Lock()
+		roomVisitUpsertCache[key] = cache
+		roomVisitUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single RoomVisit record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *RoomVisit) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single RoomVisit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RoomVisit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no RoomVisit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), roomVisitPrimaryKeyMapping)
+	sql := "DELETE FROM \"kontakt_io\".\"room_visit\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from room_visit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for room_visit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q roomVisitQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q roomVisitQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no roomVisitQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from room_visit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for room_visit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o RoomVisitSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RoomVisitSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(roomVisitBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), roomVisitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kontakt_io\".\"room_visit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, roomVisitPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from roomVisit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for room_visit")
+	}
+
+	if len(roomVisitAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *RoomVisit) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no RoomVisit provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RoomVisit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRoomVisit(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RoomVisitSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty RoomVisitSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RoomVisitSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RoomVisitSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), roomVisitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kontakt_io\".\"room_visit\".* FROM \"kontakt_io\".\"room_visit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, roomVisitPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in RoomVisitSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RoomVisitExistsG checks if the RoomVisit row exists.
+func RoomVisitExistsG(ctx context.Context, iD int64) (bool, error) {
+	return RoomVisitExists(ctx, boil.GetContextDB(), iD)
+}
+
+// RoomVisitExists checks if the RoomVisit row exists.
+func RoomVisitExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kontakt_io\".\"room_visit\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if room_visit exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RoomVisit row exists.
+func (o *RoomVisit) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RoomVisitExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1246 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SyncRun is an object representing the database table.
+type SyncRun struct {
+	ID              int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64       `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	Scope           string      `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
+	RequestedAt     null.Time   `boil:"requested_at" json:"requested_at,omitempty" toml:"requested_at" yaml:"requested_at,omitempty"`
+	StartedAt       null.Time   `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	FinishedAt      null.Time   `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+	DevicesFetched  int32       `boil:"devices_fetched" json:"devices_fetched" toml:"devices_fetched" yaml:"devices_fetched"`
+	AssetsCreated   int32       `boil:"assets_created" json:"assets_created" toml:"assets_created" yaml:"assets_created"`
+	DataPoints      int32       `boil:"data_points" json:"data_points" toml:"data_points" yaml:"data_points"`
+	LocationError   null.String `boil:"location_error" json:"location_error,omitempty" toml:"location_error" yaml:"location_error,omitempty"`
+	DeviceError     null.String `boil:"device_error" json:"device_error,omitempty" toml:"device_error" yaml:"device_error,omitempty"`
+
+	R *syncRunR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L syncRunL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SyncRunColumns = struct {
+	ID              string
+	ConfigurationID string
+	Scope           string
+	RequestedAt     string
+	StartedAt       string
+	FinishedAt      string
+	DevicesFetched  string
+	AssetsCreated   string
+	DataPoints      string
+	LocationError   string
+	DeviceError     string
+}{
+	ID:              "id",
+	ConfigurationID: "configuration_id",
+	Scope:           "scope",
+	RequestedAt:     "requested_at",
+	StartedAt:       "started_at",
+	FinishedAt:      "finished_at",
+	DevicesFetched:  "devices_fetched",
+	AssetsCreated:   "assets_created",
+	DataPoints:      "data_points",
+	LocationError:   "location_error",
+	DeviceError:     "device_error",
+}
+
+var SyncRunTableColumns = struct {
+	ID              string
+	ConfigurationID string
+	Scope           string
+	RequestedAt     string
+	StartedAt       string
+	FinishedAt      string
+	DevicesFetched  string
+	AssetsCreated   string
+	DataPoints      string
+	LocationError   string
+	DeviceError     string
+}{
+	ID:              "sync_run.id",
+	ConfigurationID: "sync_run.configuration_id",
+	Scope:           "sync_run.scope",
+	RequestedAt:     "sync_run.requested_at",
+	StartedAt:       "sync_run.started_at",
+	FinishedAt:      "sync_run.finished_at",
+	DevicesFetched:  "sync_run.devices_fetched",
+	AssetsCreated:   "sync_run.assets_created",
+	DataPoints:      "sync_run.data_points",
+	LocationError:   "sync_run.location_error",
+	DeviceError:     "sync_run.device_error",
+}
+
+// Generated where
+
+var SyncRunWhere = struct {
+	ID              whereHelperint64
+	ConfigurationID whereHelperint64
+	Scope           whereHelperstring
+	RequestedAt     whereHelpernull_Time
+	StartedAt       whereHelpernull_Time
+	FinishedAt      whereHelpernull_Time
+	DevicesFetched  whereHelperint32
+	AssetsCreated   whereHelperint32
+	DataPoints      whereHelperint32
+	LocationError   whereHelpernull_String
+	DeviceError     whereHelpernull_String
+}{
+	ID:              whereHelperint64{field: "\"kontakt_io\".\"sync_run\".\"id\""},
+	ConfigurationID: whereHelperint64{field: "\"kontakt_io\".\"sync_run\".\"configuration_id\""},
+	Scope:           whereHelperstring{field: "\"kontakt_io\".\"sync_run\".\"scope\""},
+	RequestedAt:     whereHelpernull_Time{field: "\"kontakt_io\".\"sync_run\".\"requested_at\""},
+	StartedAt:       whereHelpernull_Time{field: "\"kontakt_io\".\"sync_run\".\"started_at\""},
+	FinishedAt:      whereHelpernull_Time{field: "\"kontakt_io\".\"sync_run\".\"finished_at\""},
+	DevicesFetched:  whereHelperint32{field: "\"kontakt_io\".\"sync_run\".\"devices_fetched\""},
+	AssetsCreated:   whereHelperint32{field: "\"kontakt_io\".\"sync_run\".\"assets_created\""},
+	DataPoints:      whereHelperint32{field: "\"kontakt_io\".\"sync_run\".\"data_points\""},
+	LocationError:   whereHelpernull_String{field: "\"kontakt_io\".\"sync_run\".\"location_error\""},
+	DeviceError:     whereHelpernull_String{field: "\"kontakt_io\".\"sync_run\".\"device_error\""},
+}
+
+// SyncRunRels is where relationship names are stored.
+var SyncRunRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// syncRunR is where relationships are stored.
+type syncRunR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*syncRunR) NewStruct() *syncRunR {
+	return &syncRunR{}
+}
+
+func (r *syncRunR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// syncRunL is where Load methods for each relationship are stored.
+type syncRunL struct{}
+
+var (
+	syncRunAllColumns            = []string{"id", "configuration_id", "scope", "requested_at", "started_at", "finished_at", "devices_fetched", "assets_created", "data_points", "location_error", "device_error"}
+	syncRunColumnsWithoutDefault = []string{"configuration_id"}
+	syncRunColumnsWithDefault    = []string{"id", "scope", "requested_at", "started_at", "finished_at", "devices_fetched", "assets_created", "data_points", "location_error", "device_error"}
+	syncRunPrimaryKeyColumns     = []string{"id"}
+	syncRunGeneratedColumns      = []string{}
+)
+
+type (
+	// SyncRunSlice is an alias for a slice of pointers to SyncRun.
+	// This should almost always be used instead of []SyncRun.
+	SyncRunSlice []*SyncRun
+	// SyncRunHook is the signature for custom SyncRun hook methods
+	SyncRunHook func(context.Context, boil.ContextExecutor, *SyncRun) error
+
+	syncRunQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	syncRunType                 = reflect.TypeOf(&SyncRun{})
+	syncRunMapping              = queries.MakeStructMapping(syncRunType)
+	syncRunPrimaryKeyMapping, _ = queries.BindMapping(syncRunType, syncRunMapping, syncRunPrimaryKeyColumns)
+	syncRunInsertCacheMut       sync.RWMutex
+	syncRunInsertCache          = make(map[string]insertCache)
+	syncRunUpdateCacheMut       sync.RWMutex
+	syncRunUpdateCache          = make(map[string]updateCache)
+	syncRunUpsertCacheMut       sync.RWMutex
+	syncRunUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var syncRunAfterSelectHooks []SyncRunHook
+
+var syncRunBeforeInsertHooks []SyncRunHook
+var syncRunAfterInsertHooks []SyncRunHook
+
+var syncRunBeforeUpdateHooks []SyncRunHook
+var syncRunAfterUpdateHooks []SyncRunHook
+
+var syncRunBeforeDeleteHooks []SyncRunHook
+var syncRunAfterDeleteHooks []SyncRunHook
+
+var syncRunBeforeUpsertHooks []SyncRunHook
+var syncRunAfterUpsertHooks []SyncRunHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SyncRun) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncRunAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SyncRun) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncRunBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SyncRun) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncRunAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SyncRun) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncRunBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SyncRun) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncRunAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SyncRun) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncRunBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SyncRun) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncRunAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SyncRun) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncRunBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SyncRun) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncRunAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSyncRunHook registers your hook function for all future operations.
+func AddSyncRunHook(hookPoint boil.HookPoint, syncRunHook SyncRunHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		syncRunAfterSelectHooks = append(syncRunAfterSelectHooks, syncRunHook)
+	case boil.BeforeInsertHook:
+		syncRunBeforeInsertHooks = append(syncRunBeforeInsertHooks, syncRunHook)
+	case boil.AfterInsertHook:
+		syncRunAfterInsertHooks = append(syncRunAfterInsertHooks, syncRunHook)
+	case boil.BeforeUpdateHook:
+		syncRunBeforeUpdateHooks = append(syncRunBeforeUpdateHooks, syncRunHook)
+	case boil.AfterUpdateHook:
+		syncRunAfterUpdateHooks = append(syncRunAfterUpdateHooks, syncRunHook)
+	case boil.BeforeDeleteHook:
+		syncRunBeforeDeleteHooks = append(syncRunBeforeDeleteHooks, syncRunHook)
+	case boil.AfterDeleteHook:
+		syncRunAfterDeleteHooks = append(syncRunAfterDeleteHooks, syncRunHook)
+	case boil.BeforeUpsertHook:
+		syncRunBeforeUpsertHooks = append(syncRunBeforeUpsertHooks, syncRunHook)
+	case boil.AfterUpsertHook:
+		syncRunAfterUpsertHooks = append(syncRunAfterUpsertHooks, syncRunHook)
+	}
+}
+
+// OneG returns a single syncRun record from the query using the global executor.
+func (q syncRunQuery) OneG(ctx context.Context) (*SyncRun, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single syncRun record from the query.
+func (q syncRunQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SyncRun, error) {
+	o := &SyncRun{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for sync_run")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all SyncRun records from the query using the global executor.
+func (q syncRunQuery) AllG(ctx context.Context) (SyncRunSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all SyncRun records from the query.
+func (q syncRunQuery) All(ctx context.Context, exec boil.ContextExecutor) (SyncRunSlice, error) {
+	var o []*SyncRun
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to SyncRun slice")
+	}
+
+	if len(syncRunAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all SyncRun records in the query using the global executor
+func (q syncRunQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all SyncRun records in the query.
+func (q syncRunQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count sync_run rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q syncRunQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q syncRunQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if sync_run exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *SyncRun) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (syncRunL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSyncRun interface{}, mods queries.Applicator) error {
+	var slice []*SyncRun
+	var object *SyncRun
+
+	if singular {
+		var ok bool
+		object, ok = maybeSyncRun.(*SyncRun)
+		if !ok {
+			object = new(SyncRun)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSyncRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSyncRun))
+			}
+		}
+	} else {
+		s, ok := maybeSyncRun.(*[]*SyncRun)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSyncRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSyncRun))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &syncRunR{}
+		}
+		args = append(args, object.ConfigurationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &syncRunR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ConfigurationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ConfigurationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.configuration`),
+		qm.WhereIn(`kontakt_io.configuration.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.SyncRuns = append(foreign.R.SyncRuns, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.SyncRuns = append(foreign.R.SyncRuns, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the syncRun to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SyncRuns.
+// Uses the global database handle.
+func (o *SyncRun) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the syncRun to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SyncRuns.
+func (o *SyncRun) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kontakt_io\".\"sync_run\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, syncRunPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &syncRunR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			SyncRuns: SyncRunSlice{o},
+		}
+	} else {
+		related.R.SyncRuns = append(related.R.SyncRuns, o)
+	}
+
+	return nil
+}
+
+// SyncRuns retrieves all the records using an executor.
+func SyncRuns(mods ...qm.QueryMod) syncRunQuery {
+	mods = append(mods, qm.From("\"kontakt_io\".\"sync_run\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kontakt_io\".\"sync_run\".*"})
+	}
+
+	return syncRunQuery{q}
+}
+
+// FindSyncRunG retrieves a single record by ID.
+func FindSyncRunG(ctx context.Context, iD int64, selectCols ...string) (*SyncRun, error) {
+	return FindSyncRun(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindSyncRun retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSyncRun(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*SyncRun, error) {
+	syncRunObj := &SyncRun{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kontakt_io\".\"sync_run\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, syncRunObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from sync_run")
+	}
+
+	if err = syncRunObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return syncRunObj, err
+	}
+
+	return syncRunObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *SyncRun) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SyncRun) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no sync_run provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(syncRunColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	syncRunInsertCacheMut.RLock()
+	cache, cached := syncRunInsertCache[key]
+	syncRunInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			syncRunAllColumns,
+			syncRunColumnsWithDefault,
+			syncRunColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(syncRunType, syncRunMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(syncRunType, syncRunMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kontakt_io\".\"sync_run\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kontakt_io\".\"sync_run\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into sync_run")
+	}
+
+	if !cached {
+		syncRunInsertCacheMut.Lock()
+		syncRunInsertCache[key] = cache
+		syncRunInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single SyncRun record using the global executor.
+// See Update for more documentation.
+func (o *SyncRun) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the SyncRun.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SyncRun) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	syncRunUpdateCacheMut.RLock()
+	cache, cached := syncRunUpdateCache[key]
+	syncRunUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			syncRunAllColumns,
+			syncRunPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update sync_run, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kontakt_io\".\"sync_run\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, syncRunPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(syncRunType, syncRunMapping, append(wl, syncRunPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update sync_run row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for sync_run")
+	}
+
+	if !cached {
+		syncRunUpdateCacheMut.Lock()
+		syncRunUpdateCache[key] = cache
+		syncRunUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q syncRunQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q syncRunQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for sync_run")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for sync_run")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o SyncRunSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SyncRunSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syncRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kontakt_io\".\"sync_run\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, syncRunPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in syncRun slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all syncRun")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *SyncRun) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SyncRun) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no sync_run provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(syncRunColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	syncRunUpsertCacheMut.RLock()
+	cache, cached := syncRunUpsertCache[key]
+	syncRunUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			syncRunAllColumns,
+			syncRunColumnsWithDefault,
+			syncRunColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			syncRunAllColumns,
+			syncRunPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert sync_run, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(syncRunPrimaryKeyColumns))
+			copy(conflict, syncRunPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kontakt_io\".\"sync_run\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(syncRunType, syncRunMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(syncRunType, syncRunMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert sync_run")
+	}
+
+	if !cached {
+		syncRunUpsertCacheMut.Lock()
+		syncRunUpsertCache[key] = cache
+		syncRunUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single SyncRun record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *SyncRun) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single SyncRun record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SyncRun) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no SyncRun provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), syncRunPrimaryKeyMapping)
+	sql := "DELETE FROM \"kontakt_io\".\"sync_run\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from sync_run")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for sync_run")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q syncRunQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q syncRunQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no syncRunQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from sync_run")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for sync_run")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o SyncRunSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SyncRunSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(syncRunBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syncRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kontakt_io\".\"sync_run\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, syncRunPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from syncRun slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for sync_run")
+	}
+
+	if len(syncRunAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *SyncRun) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no SyncRun provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SyncRun) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSyncRun(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SyncRunSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty SyncRunSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SyncRunSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SyncRunSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syncRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kontakt_io\".\"sync_run\".* FROM \"kontakt_io\".\"sync_run\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, syncRunPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in SyncRunSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SyncRunExistsG checks if the SyncRun row exists.
+func SyncRunExistsG(ctx context.Context, iD int64) (bool, error) {
+	return SyncRunExists(ctx, boil.GetContextDB(), iD)
+}
+
+// SyncRunExists checks if the SyncRun row exists.
+func SyncRunExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kontakt_io\".\"sync_run\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if sync_run exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SyncRun row exists.
+func (o *SyncRun) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SyncRunExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1457 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// Zone is an object representing the database table.
+type Zone struct {
+	ID              int64            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64            `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	Name            string           `boil:"name" json:"name" toml:"name" yaml:"name"`
+	FloorID         null.Int32       `boil:"floor_id" json:"floor_id,omitempty" toml:"floor_id" yaml:"floor_id,omitempty"`
+	Polygon         null.JSON        `boil:"polygon" json:"polygon,omitempty" toml:"polygon" yaml:"polygon,omitempty"`
+	RoomIds         types.Int64Array `boil:"room_ids" json:"room_ids,omitempty" toml:"room_ids" yaml:"room_ids,omitempty"`
+	DwellThreshold  null.Int32       `boil:"dwell_threshold" json:"dwell_threshold,omitempty" toml:"dwell_threshold" yaml:"dwell_threshold,omitempty"`
+	AllowedFilter   null.JSON        `boil:"allowed_filter" json:"allowed_filter,omitempty" toml:"allowed_filter" yaml:"allowed_filter,omitempty"`
+	RaiseAlarm      bool             `boil:"raise_alarm" json:"raise_alarm" toml:"raise_alarm" yaml:"raise_alarm"`
+
+	R *zoneR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L zoneL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ZoneColumns = struct {
+	ID              string
+	ConfigurationID string
+	Name            string
+	FloorID         string
+	Polygon         string
+	RoomIds         string
+	DwellThreshold  string
+	AllowedFilter   string
+	RaiseAlarm      string
+}{
+	ID:              "id",
+	ConfigurationID: "configuration_id",
+	Name:            "name",
+	FloorID:         "floor_id",
+	Polygon:         "polygon",
+	RoomIds:         "room_ids",
+	DwellThreshold:  "dwell_threshold",
+	AllowedFilter:   "allowed_filter",
+	RaiseAlarm:      "raise_alarm",
+}
+
+var ZoneTableColumns = struct {
+	ID              string
+	ConfigurationID string
+	Name            string
+	FloorID         string
+	Polygon         string
+	RoomIds         string
+	DwellThreshold  string
+	AllowedFilter   string
+	RaiseAlarm      string
+}{
+	ID:              "zone.id",
+	ConfigurationID: "zone.configuration_id",
+	Name:            "zone.name",
+	FloorID:         "zone.floor_id",
+	Polygon:         "zone.polygon",
+	RoomIds:         "zone.room_ids",
+	DwellThreshold:  "zone.dwell_threshold",
+	AllowedFilter:   "zone.allowed_filter",
+	RaiseAlarm:      "zone.raise_alarm",
+}
+
+// Generated where
+
+type whereHelpertypes_Int64Array struct{ field string }
+
+func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpertypes_Int64Array) NEQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpertypes_Int64Array) LT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Int64Array) LTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Int64Array) GT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpertypes_Int64Array) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpertypes_Int64Array) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var ZoneWhere = struct {
+	ID              whereHelperint64
+	ConfigurationID whereHelperint64
+	Name            whereHelperstring
+	FloorID         whereHelpernull_Int32
+	Polygon         whereHelpernull_JSON
+	RoomIds         whereHelpertypes_Int64Array
+	DwellThreshold  whereHelpernull_Int32
+	AllowedFilter   whereHelpernull_JSON
+	RaiseAlarm      whereHelperbool
+}{
+	ID:              whereHelperint64{field: "\"kontakt_io\".\"zone\".\"id\""},
+	ConfigurationID: whereHelperint64{field: "\"kontakt_io\".\"zone\".\"configuration_id\""},
+	Name:            whereHelperstring{field: "\"kontakt_io\".\"zone\".\"name\""},
+	FloorID:         whereHelpernull_Int32{field: "\"kontakt_io\".\"zone\".\"floor_id\""},
+	Polygon:         whereHelpernull_JSON{field: "\"kontakt_io\".\"zone\".\"polygon\""},
+	RoomIds:         whereHelpertypes_Int64Array{field: "\"kontakt_io\".\"zone\".\"room_ids\""},
+	DwellThreshold:  whereHelpernull_Int32{field: "\"kontakt_io\".\"zone\".\"dwell_threshold\""},
+	AllowedFilter:   whereHelpernull_JSON{field: "\"kontakt_io\".\"zone\".\"allowed_filter\""},
+	RaiseAlarm:      whereHelperbool{field: "\"kontakt_io\".\"zone\".\"raise_alarm\""},
+}
+
+// ZoneRels is where relationship names are stored.
+var ZoneRels = struct {
+	Configuration string
+	ZonePresences string
+}{
+	Configuration: "Configuration",
+	ZonePresences: "ZonePresences",
+}
+
+// zoneR is where relationships are stored.
+type zoneR struct {
+	Configuration *Configuration    `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+	ZonePresences ZonePresenceSlice `boil:"ZonePresences" json:"ZonePresences" toml:"ZonePresences" yaml:"ZonePresences"`
+}
+
+// NewStruct creates a new relationship struct
+func (*zoneR) NewStruct() *zoneR {
+	return &zoneR{}
+}
+
+func (r *zoneR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+func (r *zoneR) GetZonePresences() ZonePresenceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ZonePresences
+}
+
+// zoneL is where Load methods for each relationship are stored.
+type zoneL struct{}
+
+var (
+	zoneAllColumns            = []string{"id", "configuration_id", "name", "floor_id", "polygon", "room_ids", "dwell_threshold", "allowed_filter", "raise_alarm"}
+	zoneColumnsWithoutDefault = []string{"configuration_id", "name"}
+	zoneColumnsWithDefault    = []string{"id", "floor_id", "polygon", "room_ids", "dwell_threshold", "allowed_filter", "raise_alarm"}
+	zonePrimaryKeyColumns     = []string{"id"}
+	zoneGeneratedColumns      = []string{}
+)
+
+type (
+	// ZoneSlice is an alias for a slice of pointers to Zone.
+	// This should almost always be used instead of []Zone.
+	ZoneSlice []*Zone
+	// ZoneHook is the signature for custom Zone hook methods
+	ZoneHook func(context.Context, boil.ContextExecutor, *Zone) error
+
+	zoneQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	zoneType                 = reflect.TypeOf(&Zone{})
+	zoneMapping              = queries.MakeStructMapping(zoneType)
+	zonePrimaryKeyMapping, _ = queries.BindMapping(zoneType, zoneMapping, zonePrimaryKeyColumns)
+	zoneInsertCacheMut       sync.RWMutex
+	zoneInsertCache          = make(map[string]insertCache)
+	zoneUpdateCacheMut       sync.RWMutex
+	zoneUpdateCache          = make(map[string]updateCache)
+	zoneUpsertCacheMut       sync.RWMutex
+	zoneUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var zoneAfterSelectHooks []ZoneHook
+
+var zoneBeforeInsertHooks []ZoneHook
+var zoneAfterInsertHooks []ZoneHook
+
+var zoneBeforeUpdateHooks []ZoneHook
+var zoneAfterUpdateHooks []ZoneHook
+
+var zoneBeforeDeleteHooks []ZoneHook
+var zoneAfterDeleteHooks []ZoneHook
+
+var zoneBeforeUpsertHooks []ZoneHook
+var zoneAfterUpsertHooks []ZoneHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Zone) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zoneAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Zone) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zoneBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Zone) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zoneAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Zone) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zoneBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Zone) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zoneAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Zone) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zoneBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Zone) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zoneAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Zone) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zoneBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Zone) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zoneAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddZoneHook registers your hook function for all future operations.
+func AddZoneHook(hookPoint boil.HookPoint, zoneHook ZoneHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		zoneAfterSelectHooks = append(zoneAfterSelectHooks, zoneHook)
+	case boil.BeforeInsertHook:
+		zoneBeforeInsertHooks = append(zoneBeforeInsertHooks, zoneHook)
+	case boil.AfterInsertHook:
+		zoneAfterInsertHooks = append(zoneAfterInsertHooks, zoneHook)
+	case boil.BeforeUpdateHook:
+		zoneBeforeUpdateHooks = append(zoneBeforeUpdateHooks, zoneHook)
+	case boil.AfterUpdateHook:
+		zoneAfterUpdateHooks = append(zoneAfterUpdateHooks, zoneHook)
+	case boil.BeforeDeleteHook:
+		zoneBeforeDeleteHooks = append(zoneBeforeDeleteHooks, zoneHook)
+	case boil.AfterDeleteHook:
+		zoneAfterDeleteHooks = append(zoneAfterDeleteHooks, zoneHook)
+	case boil.BeforeUpsertHook:
+		zoneBeforeUpsertHooks = append(zoneBeforeUpsertHooks, zoneHook)
+	case boil.AfterUpsertHook:
+		zoneAfterUpsertHooks = append(zoneAfterUpsertHooks, zoneHook)
+	}
+}
+
+// OneG returns a single zone record from the query using the global executor.
+func (q zoneQuery) OneG(ctx context.Context) (*Zone, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single zone record from the query.
+func (q zoneQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Zone, error) {
+	o := &Zone{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for zone")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Zone records from the query using the global executor.
+func (q zoneQuery) AllG(ctx context.Context) (ZoneSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Zone records from the query.
+func (q zoneQuery) All(ctx context.Context, exec boil.ContextExecutor) (ZoneSlice, error) {
+	var o []*Zone
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to Zone slice")
+	}
+
+	if len(zoneAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Zone records in the query using the global executor
+func (q zoneQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Zone records in the query.
+func (q zoneQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count zone rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q zoneQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q zoneQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if zone exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *Zone) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// ZonePresences retrieves all the zone_presence's ZonePresences with an executor.
+func (o *Zone) ZonePresences(mods ...qm.QueryMod) zonePresenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kontakt_io\".\"zone_presence\".\"zone_id\"=?", o.ID),
+	)
+
+	return ZonePresences(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (zoneL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeZone interface{}, mods queries.Applicator) error {
+	var slice []*Zone
+	var object *Zone
+
+	if singular {
+		var ok bool
+		object, ok = maybeZone.(*Zone)
+		if !ok {
+			object = new(Zone)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeZone)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeZone))
+			}
+		}
+	} else {
+		s, ok := maybeZone.(*[]*Zone)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeZone)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeZone))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &zoneR{}
+		}
+		args = append(args, object.ConfigurationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &zoneR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ConfigurationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ConfigurationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.configuration`),
+		qm.WhereIn(`kontakt_io.configuration.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.Zones = append(foreign.R.Zones, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.Zones = append(foreign.R.Zones, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadZonePresences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (zoneL) LoadZonePresences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeZone interface{}, mods queries.Applicator) error {
+	var slice []*Zone
+	var object *Zone
+
+	if singular {
+		var ok bool
+		object, ok = maybeZone.(*Zone)
+		if !ok {
+			object = new(Zone)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeZone)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeZone))
+			}
+		}
+	} else {
+		s, ok := maybeZone.(*[]*Zone)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeZone)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeZone))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &zoneR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &zoneR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.zone_presence`),
+		qm.WhereIn(`kontakt_io.zone_presence.zone_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load zone_presence")
+	}
+
+	var resultSlice []*ZonePresence
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice zone_presence")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on zone_presence")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for zone_presence")
+	}
+
+	if len(zonePresenceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ZonePresences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &zonePresenceR{}
+			}
+			foreign.R.Zone = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ZoneID {
+				local.R.ZonePresences = append(local.R.ZonePresences, foreign)
+				if foreign.R == nil {
+					foreign.R = &zonePresenceR{}
+				}
+				foreign.R.Zone = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the zone to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.Zones.
+// Uses the global database handle.
+func (o *Zone) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the zone to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.Zones.
+func (o *Zone) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kontakt_io\".\"zone\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, zonePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &zoneR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			Zones: ZoneSlice{o},
+		}
+	} else {
+		related.R.Zones = append(related.R.Zones, o)
+	}
+
+	return nil
+}
+
+// AddZonePresencesG adds the given related objects to the existing relationships
+// of the zone, optionally inserting them as new records.
+// Appends related to o.R.ZonePresences.
+// Sets related.R.Zone appropriately.
+// Uses the global database handle.
+func (o *Zone) AddZonePresencesG(ctx context.Context, insert bool, related ...*ZonePresence) error {
+	return o.AddZonePresences(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddZonePresences adds the given related objects to the existing relationships
+// of the zone, optionally inserting them as new records.
+// Appends related to o.R.ZonePresences.
+// Sets related.R.Zone appropriately.
+func (o *Zone) AddZonePresences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ZonePresence) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ZoneID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kontakt_io\".\"zone_presence\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"zone_id"}),
+				strmangle.WhereClause("\"", "\"", 2, zonePresencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ZoneID, rel.DeviceID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ZoneID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &zoneR{
+			ZonePresences: related,
+		}
+	} else {
+		o.R.ZonePresences = append(o.R.ZonePresences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &zonePresenceR{
+				Zone: o,
+			}
+		} else {
+			rel.R.Zone = o
+		}
+	}
+	return nil
+}
+
+// Zones retrieves all the records using an executor.
+func Zones(mods ...qm.QueryMod) zoneQuery {
+	mods = append(mods, qm.From("\"kontakt_io\".\"zone\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kontakt_io\".\"zone\".*"})
+	}
+
+	return zoneQuery{q}
+}
+
+// FindZoneG retrieves a single record by ID.
+func FindZoneG(ctx context.Context, iD int64, selectCols ...string) (*Zone, error) {
+	return FindZone(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindZone retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindZone(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*Zone, error) {
+	zoneObj := &Zone{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kontakt_io\".\"zone\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, zoneObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from zone")
+	}
+
+	if err = zoneObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return zoneObj, err
+	}
+
+	return zoneObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Zone) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Zone) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no zone provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(zoneColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	zoneInsertCacheMut.RLock()
+	cache, cached := zoneInsertCache[key]
+	zoneInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			zoneAllColumns,
+			zoneColumnsWithDefault,
+			zoneColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(zoneType, zoneMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(zoneType, zoneMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kontakt_io\".\"zone\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kontakt_io\".\"zone\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into zone")
+	}
+
+	if !cached {
+		zoneInsertCacheMut.Lock()
+		zoneInsertCache[key] = cache
+		zoneInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single Zone record using the global executor.
+// See Update for more documentation.
+func (o *Zone) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Zone.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Zone) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	zoneUpdateCacheMut.RLock()
+	cache, cached := zoneUpdateCache[key]
+	zoneUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			zoneAllColumns,
+			zonePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update zone, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kontakt_io\".\"zone\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, zonePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(zoneType, zoneMapping, append(wl, zonePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update zone row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for zone")
+	}
+
+	if !cached {
+		zoneUpdateCacheMut.Lock()
+		zoneUpdateCache[key] = cache
+		zoneUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q zoneQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q zoneQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for zone")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for zone")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ZoneSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ZoneSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), zonePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kontakt_io\".\"zone\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, zonePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in zone slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all zone")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Zone) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Zone) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no zone provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(zoneColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	zoneUpsertCacheMut.RLock()
+	cache, cached := zoneUpsertCache[key]
+	zoneUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			zoneAllColumns,
+			zoneColumnsWithDefault,
+			zoneColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			zoneAllColumns,
+			zonePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert zone, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(zonePrimaryKeyColumns))
+			copy(conflict, zonePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kontakt_io\".\"zone\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(zoneType, zoneMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(zoneType, zoneMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert zone")
+	}
+
+	if !cached {
+		zoneUpsertCacheMut.Lock()
+		zoneUpsertCache[key] = cache
+		zoneUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single Zone record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Zone) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Zone record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Zone) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no Zone provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), zonePrimaryKeyMapping)
+	sql := "DELETE FROM \"kontakt_io\".\"zone\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from zone")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for zone")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q zoneQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q zoneQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no zoneQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from zone")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for zone")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ZoneSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ZoneSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(zoneBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), zonePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kontakt_io\".\"zone\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, zonePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from zone slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for zone")
+	}
+
+	if len(zoneAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Zone) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no Zone provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Zone) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindZone(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ZoneSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty ZoneSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ZoneSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ZoneSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), zonePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kontakt_io\".\"zone\".* FROM \"kontakt_io\".\"zone\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, zonePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in ZoneSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ZoneExistsG checks if the Zone row exists.
+func ZoneExistsG(ctx context.Context, iD int64) (bool, error) {
+	return ZoneExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ZoneExists checks if the Zone row exists.
+func ZoneExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kontakt_io\".\"zone\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if zone exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Zone row exists.
+func (o *Zone) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ZoneExists(ctx, exec, o.ID)
+}
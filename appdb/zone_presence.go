@@ -0,0 +1,1217 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ZonePresence is an object representing the database table.
+type ZonePresence struct {
+	ZoneID        int64     `boil:"zone_id" json:"zone_id" toml:"zone_id" yaml:"zone_id"`
+	DeviceID      string    `boil:"device_id" json:"device_id" toml:"device_id" yaml:"device_id"`
+	DeviceType    string    `boil:"device_type" json:"device_type" toml:"device_type" yaml:"device_type"`
+	EnteredAt     time.Time `boil:"entered_at" json:"entered_at" toml:"entered_at" yaml:"entered_at"`
+	LastSeenAt    time.Time `boil:"last_seen_at" json:"last_seen_at" toml:"last_seen_at" yaml:"last_seen_at"`
+	DwellExceeded bool      `boil:"dwell_exceeded" json:"dwell_exceeded" toml:"dwell_exceeded" yaml:"dwell_exceeded"`
+	Unauthorized  bool      `boil:"unauthorized" json:"unauthorized" toml:"unauthorized" yaml:"unauthorized"`
+
+	R *zonePresenceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L zonePresenceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ZonePresenceColumns = struct {
+	ZoneID        string
+	DeviceID      string
+	DeviceType    string
+	EnteredAt     string
+	LastSeenAt    string
+	DwellExceeded string
+	Unauthorized  string
+}{
+	ZoneID:        "zone_id",
+	DeviceID:      "device_id",
+	DeviceType:    "device_type",
+	EnteredAt:     "entered_at",
+	LastSeenAt:    "last_seen_at",
+	DwellExceeded: "dwell_exceeded",
+	Unauthorized:  "unauthorized",
+}
+
+var ZonePresenceTableColumns = struct {
+	ZoneID        string
+	DeviceID      string
+	DeviceType    string
+	EnteredAt     string
+	LastSeenAt    string
+	DwellExceeded string
+	Unauthorized  string
+}{
+	ZoneID:        "zone_presence.zone_id",
+	DeviceID:      "zone_presence.device_id",
+	DeviceType:    "zone_presence.device_type",
+	EnteredAt:     "zone_presence.entered_at",
+	LastSeenAt:    "zone_presence.last_seen_at",
+	DwellExceeded: "zone_presence.dwell_exceeded",
+	Unauthorized:  "zone_presence.unauthorized",
+}
+
+// Generated where
+
+var ZonePresenceWhere = struct {
+	ZoneID        whereHelperint64
+	DeviceID      whereHelperstring
+	DeviceType    whereHelperstring
+	EnteredAt     whereHelpertime_Time
+	LastSeenAt    whereHelpertime_Time
+	DwellExceeded whereHelperbool
+	Unauthorized  whereHelperbool
+}{
+	ZoneID:        whereHelperint64{field: "\"kontakt_io\".\"zone_presence\".\"zone_id\""},
+	DeviceID:      whereHelperstring{field: "\"kontakt_io\".\"zone_presence\".\"device_id\""},
+	DeviceType:    whereHelperstring{field: "\"kontakt_io\".\"zone_presence\".\"device_type\""},
+	EnteredAt:     whereHelpertime_Time{field: "\"kontakt_io\".\"zone_presence\".\"entered_at\""},
+	LastSeenAt:    whereHelpertime_Time{field: "\"kontakt_io\".\"zone_presence\".\"last_seen_at\""},
+	DwellExceeded: whereHelperbool{field: "\"kontakt_io\".\"zone_presence\".\"dwell_exceeded\""},
+	Unauthorized:  whereHelperbool{field: "\"kontakt_io\".\"zone_presence\".\"unauthorized\""},
+}
+
+// ZonePresenceRels is where relationship names are stored.
+var ZonePresenceRels = struct {
+	Zone string
+}{
+	Zone: "Zone",
+}
+
+// zonePresenceR is where relationships are stored.
+type zonePresenceR struct {
+	Zone *Zone `boil:"Zone" json:"Zone" toml:"Zone" yaml:"Zone"`
+}
+
+// NewStruct creates a new relationship struct
+func (*zonePresenceR) NewStruct() *zonePresenceR {
+	return &zonePresenceR{}
+}
+
+func (r *zonePresenceR) GetZone() *Zone {
+	if r == nil {
+		return nil
+	}
+	return r.Zone
+}
+
+// zonePresenceL is where Load methods for each relationship are stored.
+type zonePresenceL struct{}
+
+var (
+	zonePresenceAllColumns            = []string{"zone_id", "device_id", "device_type", "entered_at", "last_seen_at", "dwell_exceeded", "unauthorized"}
+	zonePresenceColumnsWithoutDefault = []string{"zone_id", "device_id", "device_type", "entered_at", "last_seen_at"}
+	zonePresenceColumnsWithDefault    = []string{"dwell_exceeded", "unauthorized"}
+	zonePresencePrimaryKeyColumns     = []string{"zone_id", "device_id"}
+	zonePresenceGeneratedColumns      = []string{}
+)
+
+type (
+	// ZonePresenceSlice is an alias for a slice of pointers to ZonePresence.
+	// This should almost always be used instead of []ZonePresence.
+	ZonePresenceSlice []*ZonePresence
+	// ZonePresenceHook is the signature for custom ZonePresence hook methods
+	ZonePresenceHook func(context.Context, boil.ContextExecutor, *ZonePresence) error
+
+	zonePresenceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	zonePresenceType                 = reflect.TypeOf(&ZonePresence{})
+	zonePresenceMapping              = queries.MakeStructMapping(zonePresenceType)
+	zonePresencePrimaryKeyMapping, _ = queries.BindMapping(zonePresenceType, zonePresenceMapping, zonePresencePrimaryKeyColumns)
+	zonePresenceInsertCacheMut       sync.RWMutex
+	zonePresenceInsertCache          = make(map[string]insertCache)
+	zonePresenceUpdateCacheMut       sync.RWMutex
+	zonePresenceUpdateCache          = make(map[string]updateCache)
+	zonePresenceUpsertCacheMut       sync.RWMutex
+	zonePresenceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var zonePresenceAfterSelectHooks []ZonePresenceHook
+
+var zonePresenceBeforeInsertHooks []ZonePresenceHook
+var zonePresenceAfterInsertHooks []ZonePresenceHook
+
+var zonePresenceBeforeUpdateHooks []ZonePresenceHook
+var zonePresenceAfterUpdateHooks []ZonePresenceHook
+
+var zonePresenceBeforeDeleteHooks []ZonePresenceHook
+var zonePresenceAfterDeleteHooks []ZonePresenceHook
+
+var zonePresenceBeforeUpsertHooks []ZonePresenceHook
+var zonePresenceAfterUpsertHooks []ZonePresenceHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ZonePresence) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zonePresenceAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ZonePresence) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zonePresenceBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ZonePresence) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zonePresenceAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ZonePresence) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zonePresenceBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ZonePresence) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zonePresenceAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ZonePresence) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zonePresenceBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ZonePresence) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zonePresenceAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ZonePresence) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zonePresenceBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ZonePresence) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range zonePresenceAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddZonePresenceHook registers your hook function for all future operations.
+func AddZonePresenceHook(hookPoint boil.HookPoint, zonePresenceHook ZonePresenceHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		zonePresenceAfterSelectHooks = append(zonePresenceAfterSelectHooks, zonePresenceHook)
+	case boil.BeforeInsertHook:
+		zonePresenceBeforeInsertHooks = append(zonePresenceBeforeInsertHooks, zonePresenceHook)
+	case boil.AfterInsertHook:
+		zonePresenceAfterInsertHooks = append(zonePresenceAfterInsertHooks, zonePresenceHook)
+	case boil.BeforeUpdateHook:
+		zonePresenceBeforeUpdateHooks = append(zonePresenceBeforeUpdateHooks, zonePresenceHook)
+	case boil.AfterUpdateHook:
+		zonePresenceAfterUpdateHooks = append(zonePresenceAfterUpdateHooks, zonePresenceHook)
+	case boil.BeforeDeleteHook:
+		zonePresenceBeforeDeleteHooks = append(zonePresenceBeforeDeleteHooks, zonePresenceHook)
+	case boil.AfterDeleteHook:
+		zonePresenceAfterDeleteHooks = append(zonePresenceAfterDeleteHooks, zonePresenceHook)
+	case boil.BeforeUpsertHook:
+		zonePresenceBeforeUpsertHooks = append(zonePresenceBeforeUpsertHooks, zonePresenceHook)
+	case boil.AfterUpsertHook:
+		zonePresenceAfterUpsertHooks = append(zonePresenceAfterUpsertHooks, zonePresenceHook)
+	}
+}
+
+// OneG returns a single zonePresence record from the query using the global executor.
+func (q zonePresenceQuery) OneG(ctx context.Context) (*ZonePresence, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single zonePresence record from the query.
+func (q zonePresenceQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ZonePresence, error) {
+	o := &ZonePresence{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for zone_presence")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ZonePresence records from the query using the global executor.
+func (q zonePresenceQuery) AllG(ctx context.Context) (ZonePresenceSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ZonePresence records from the query.
+func (q zonePresenceQuery) All(ctx context.Context, exec boil.ContextExecutor) (ZonePresenceSlice, error) {
+	var o []*ZonePresence
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to ZonePresence slice")
+	}
+
+	if len(zonePresenceAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ZonePresence records in the query using the global executor
+func (q zonePresenceQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ZonePresence records in the query.
+func (q zonePresenceQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count zone_presence rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q zonePresenceQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q zonePresenceQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if zone_presence exists")
+	}
+
+	return count > 0, nil
+}
+
+// Zone pointed to by the foreign key.
+func (o *ZonePresence) Zone(mods ...qm.QueryMod) zoneQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ZoneID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Zones(queryMods...)
+}
+
+// LoadZone allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (zonePresenceL) LoadZone(ctx context.Context, e boil.ContextExecutor, singular bool, maybeZonePresence interface{}, mods queries.Applicator) error {
+	var slice []*ZonePresence
+	var object *ZonePresence
+
+	if singular {
+		var ok bool
+		object, ok = maybeZonePresence.(*ZonePresence)
+		if !ok {
+			object = new(ZonePresence)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeZonePresence)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeZonePresence))
+			}
+		}
+	} else {
+		s, ok := maybeZonePresence.(*[]*ZonePresence)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeZonePresence)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeZonePresence))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &zonePresenceR{}
+		}
+		args = append(args, object.ZoneID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &zonePresenceR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ZoneID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ZoneID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`kontakt_io.zone`),
+		qm.WhereIn(`kontakt_io.zone.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Zone")
+	}
+
+	var resultSlice []*Zone
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Zone")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for zone")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for zone")
+	}
+
+	if len(zoneAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Zone = foreign
+		if foreign.R == nil {
+			foreign.R = &zoneR{}
+		}
+		foreign.R.ZonePresences = append(foreign.R.ZonePresences, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ZoneID == foreign.ID {
+				local.R.Zone = foreign
+				if foreign.R == nil {
+					foreign.R = &zoneR{}
+				}
+				foreign.R.ZonePresences = append(foreign.R.ZonePresences, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetZoneG of the zonePresence to the related item.
+// Sets o.R.Zone to related.
+// Adds o to related.R.ZonePresences.
+// Uses the global database handle.
+func (o *ZonePresence) SetZoneG(ctx context.Context, insert bool, related *Zone) error {
+	return o.SetZone(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetZone of the zonePresence to the related item.
+// Sets o.R.Zone to related.
+// Adds o to related.R.ZonePresences.
+func (o *ZonePresence) SetZone(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Zone) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kontakt_io\".\"zone_presence\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"zone_id"}),
+		strmangle.WhereClause("\"", "\"", 2, zonePresencePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ZoneID, o.DeviceID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ZoneID = related.ID
+	if o.R == nil {
+		o.R = &zonePresenceR{
+			Zone: related,
+		}
+	} else {
+		o.R.Zone = related
+	}
+
+	if related.R == nil {
+		related.R = &zoneR{
+			ZonePresences: ZonePresenceSlice{o},
+		}
+	} else {
+		related.R.ZonePresences = append(related.R.ZonePresences, o)
+	}
+
+	return nil
+}
+
+// ZonePresences retrieves all the records using an executor.
+func ZonePresences(mods ...qm.QueryMod) zonePresenceQuery {
+	mods = append(mods, qm.From("\"kontakt_io\".\"zone_presence\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kontakt_io\".\"zone_presence\".*"})
+	}
+
+	return zonePresenceQuery{q}
+}
+
+// FindZonePresenceG retrieves a single record by ID.
+func FindZonePresenceG(ctx context.Context, zoneID int64, deviceID string, selectCols ...string) (*ZonePresence, error) {
+	return FindZonePresence(ctx, boil.GetContextDB(), zoneID, deviceID, selectCols...)
+}
+
+// FindZonePresence retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindZonePresence(ctx context.Context, exec boil.ContextExecutor, zoneID int64, deviceID string, selectCols ...string) (*ZonePresence, error) {
+	zonePresenceObj := &ZonePresence{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kontakt_io\".\"zone_presence\" where \"zone_id\"=$1 AND \"device_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, zoneID, deviceID)
+
+	err := q.Bind(ctx, exec, zonePresenceObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from zone_presence")
+	}
+
+	if err = zonePresenceObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return zonePresenceObj, err
+	}
+
+	return zonePresenceObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ZonePresence) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ZonePresence) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no zone_presence provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(zonePresenceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	zonePresenceInsertCacheMut.RLock()
+	cache, cached := zonePresenceInsertCache[key]
+	zonePresenceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			zonePresenceAllColumns,
+			zonePresenceColumnsWithDefault,
+			zonePresenceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(zonePresenceType, zonePresenceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(zonePresenceType, zonePresenceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kontakt_io\".\"zone_presence\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kontakt_io\".\"zone_presence\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into zone_presence")
+	}
+
+	if !cached {
+		zonePresenceInsertCacheMut.Lock()
+		zonePresenceInsertCache[key] = cache
+		zonePresenceInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ZonePresence record using the global executor.
+// See Update for more documentation.
+func (o *ZonePresence) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ZonePresence.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ZonePresence) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	zonePresenceUpdateCacheMut.RLock()
+	cache, cached := zonePresenceUpdateCache[key]
+	zonePresenceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			zonePresenceAllColumns,
+			zonePresencePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update zone_presence, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kontakt_io\".\"zone_presence\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, zonePresencePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(zonePresenceType, zonePresenceMapping, append(wl, zonePresencePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update zone_presence row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for zone_presence")
+	}
+
+	if !cached {
+		zonePresenceUpdateCacheMut.Lock()
+		zonePresenceUpdateCache[key] = cache
+		zonePresenceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q zonePresenceQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q zonePresenceQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for zone_presence")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for zone_presence")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ZonePresenceSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ZonePresenceSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), zonePresencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kontakt_io\".\"zone_presence\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, zonePresencePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in zonePresence slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all zonePresence")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ZonePresence) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ZonePresence) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no zone_presence provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(zonePresenceColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	zonePresenceUpsertCacheMut.RLock()
+	cache, cached := zonePresenceUpsertCache[key]
+	zonePresenceUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			zonePresenceAllColumns,
+			zonePresenceColumnsWithDefault,
+			zonePresenceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			zonePresenceAllColumns,
+			zonePresencePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert zone_presence, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(zonePresencePrimaryKeyColumns))
+			copy(conflict, zonePresencePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kontakt_io\".\"zone_presence\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(zonePresenceType, zonePresenceMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(zonePresenceType, zonePresenceMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert zone_presence")
+	}
+
+	if !cached {
+		zonePresenceUpsertCacheMut.Lock()
+		zonePresenceUpsertCache[key] = cache
+		zonePresenceUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ZonePresence record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ZonePresence) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ZonePresence record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ZonePresence) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no ZonePresence provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), zonePresencePrimaryKeyMapping)
+	sql := "DELETE FROM \"kontakt_io\".\"zone_presence\" WHERE \"zone_id\"=$1 AND \"device_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from zone_presence")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for zone_presence")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q zonePresenceQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q zonePresenceQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no zonePresenceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from zone_presence")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for zone_presence")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ZonePresenceSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ZonePresenceSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(zonePresenceBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), zonePresencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kontakt_io\".\"zone_presence\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, zonePresencePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from zonePresence slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for zone_presence")
+	}
+
+	if len(zonePresenceAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ZonePresence) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no ZonePresence provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ZonePresence) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindZonePresence(ctx, exec, o.ZoneID, o.DeviceID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ZonePresenceSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty ZonePresenceSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ZonePresenceSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ZonePresenceSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), zonePresencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kontakt_io\".\"zone_presence\".* FROM \"kontakt_io\".\"zone_presence\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, zonePresencePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in ZonePresenceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ZonePresenceExistsG checks if the ZonePresence row exists.
+func ZonePresenceExistsG(ctx context.Context, zoneID int64, deviceID string) (bool, error) {
+	return ZonePresenceExists(ctx, boil.GetContextDB(), zoneID, deviceID)
+}
+
+// ZonePresenceExists checks if the ZonePresence row exists.
+func ZonePresenceExists(ctx context.Context, exec boil.ContextExecutor, zoneID int64, deviceID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kontakt_io\".\"zone_presence\" where \"zone_id\"=$1 AND \"device_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, zoneID, deviceID)
+	}
+	row := exec.QueryRowContext(ctx, sql, zoneID, deviceID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if zone_presence exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ZonePresence row exists.
+func (o *ZonePresence) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ZonePresenceExists(ctx, exec, o.ZoneID, o.DeviceID)
+}
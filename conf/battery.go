@@ -0,0 +1,86 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/appdb"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func InsertBatteryLevel(ctx context.Context, level *appdb.BatteryLevel) error {
+	return level.InsertG(ctx, boil.Infer())
+}
+
+func DeleteBatteryLevelsBefore(ctx context.Context, configID int64, before time.Time) (int64, error) {
+	return appdb.BatteryLevels(
+		appdb.BatteryLevelWhere.ConfigurationID.EQ(configID),
+		appdb.BatteryLevelWhere.RecordedAt.LT(before),
+	).DeleteAllG(ctx)
+}
+
+// GetLatestBatteryLevels returns the last sampled battery level of each device, by device ID.
+func GetLatestBatteryLevels(ctx context.Context, configID int64) (map[string]*appdb.BatteryLevel, error) {
+	var levels appdb.BatteryLevelSlice
+	err := queries.Raw(`
+		select distinct on (device_id) *
+		from kontakt_io.battery_level
+		where configuration_id = $1
+		order by device_id, recorded_at desc`,
+		configID,
+	).BindG(ctx, &levels)
+	if err != nil {
+		return nil, fmt.Errorf("getting latest battery levels: %v", err)
+	}
+	latest := make(map[string]*appdb.BatteryLevel, len(levels))
+	for _, level := range levels {
+		latest[level.DeviceID] = level
+	}
+	return latest, nil
+}
+
+// DailyBatteryLevel is the median battery level of a device on one day.
+type DailyBatteryLevel struct {
+	DeviceID     string    `boil:"device_id"`
+	Day          time.Time `boil:"day"`
+	BatteryLevel float64   `boil:"battery_level"`
+}
+
+// GetDailyBatteryLevels returns the daily median battery levels of the devices, ordered by device
+// and day. If no device IDs are given, the levels of all devices of the configuration are returned.
+func GetDailyBatteryLevels(ctx context.Context, configID int64, deviceIDs []string) ([]DailyBatteryLevel, error) {
+	var levels []DailyBatteryLevel
+	err := queries.Raw(`
+		select device_id,
+			date_trunc('day', recorded_at) as day,
+			percentile_cont(0.5) within group (order by battery_level) as battery_level
+		from kontakt_io.battery_level
+		where configuration_id = $1
+			and (coalesce(cardinality($2::text[]), 0) = 0 or device_id = any($2::text[]))
+		group by device_id, day
+		order by device_id, day`,
+		configID, types.StringArray(deviceIDs),
+	).BindG(ctx, &levels)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating battery levels: %v", err)
+	}
+	return levels, nil
+}
@@ -17,6 +17,7 @@ package conf
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -30,10 +31,13 @@ import (
 
 var ErrBadRequest = errors.New("bad request")
 
+// defaultPositionRetention is the number of days positions are kept if not configured.
+const defaultPositionRetention = 7
+
 func InsertConfig(ctx context.Context, config apiserver.Configuration) (apiserver.Configuration, error) {
 	dbConfig, err := dbConfigFromApiConfig(config)
 	if err != nil {
-		return apiserver.Configuration{}, fmt.Errorf("creating DB config from API config: %v", err)
+		return apiserver.Configuration{}, fmt.Errorf("creating DB config from API config: %w", err)
 	}
 	if err := dbConfig.InsertG(ctx, boil.Infer()); err != nil {
 		return apiserver.Configuration{}, fmt.Errorf("inserting DB config: %v", err)
@@ -44,7 +48,7 @@ func InsertConfig(ctx context.Context, config apiserver.Configuration) (apiserve
 func UpsertConfig(ctx context.Context, config apiserver.Configuration) (apiserver.Configuration, error) {
 	dbConfig, err := dbConfigFromApiConfig(config)
 	if err != nil {
-		return apiserver.Configuration{}, fmt.Errorf("creating DB config from API config: %v", err)
+		return apiserver.Configuration{}, fmt.Errorf("creating DB config from API config: %w", err)
 	}
 	if err := dbConfig.UpsertG(ctx, true, []string{"id"}, boil.Blacklist("id"), boil.Infer()); err != nil {
 		return apiserver.Configuration{}, fmt.Errorf("inserting DB config: %v", err)
@@ -103,11 +107,60 @@ func dbConfigFromApiConfig(apiConfig apiserver.Configuration) (dbConfig appdb.Co
 	if apiConfig.RequestTimeout != nil {
 		dbConfig.RequestTimeout = *apiConfig.RequestTimeout
 	}
+	if apiConfig.AlarmThresholds != nil {
+		at, err := json.Marshal(apiConfig.AlarmThresholds)
+		if err != nil {
+			return appdb.Configuration{}, fmt.Errorf("marshalling alarmThresholds: %v", err)
+		}
+		dbConfig.AlarmThresholds = null.JSONFrom(at)
+	}
+	if apiConfig.FirmwareVersions != nil {
+		fv, err := json.Marshal(apiConfig.FirmwareVersions)
+		if err != nil {
+			return appdb.Configuration{}, fmt.Errorf("marshalling firmwareVersions: %v", err)
+		}
+		dbConfig.FirmwareVersions = null.JSONFrom(fv)
+	}
+	if apiConfig.ProjectMappings != nil {
+		projectIds := make(map[string]bool)
+		if apiConfig.ProjectIDs != nil {
+			for _, projectId := range *apiConfig.ProjectIDs {
+				projectIds[projectId] = true
+			}
+		}
+		mapped := make(map[string]bool)
+		for _, mapping := range apiConfig.ProjectMappings {
+			if !projectIds[mapping.ProjectId] {
+				return appdb.Configuration{}, fmt.Errorf("%w: project mapping for project %s not listed in projectIDs", ErrBadRequest, mapping.ProjectId)
+			}
+			if mapped[mapping.ProjectId] {
+				return appdb.Configuration{}, fmt.Errorf("%w: more than one project mapping for project %s", ErrBadRequest, mapping.ProjectId)
+			}
+			mapped[mapping.ProjectId] = true
+		}
+		pm, err := json.Marshal(apiConfig.ProjectMappings)
+		if err != nil {
+			return appdb.Configuration{}, fmt.Errorf("marshalling projectMappings: %v", err)
+		}
+		dbConfig.ProjectMappings = null.JSONFrom(pm)
+	}
+	dbConfig.PositionRetention = defaultPositionRetention
+	if apiConfig.PositionRetention != nil {
+		if *apiConfig.PositionRetention < 1 {
+			return appdb.Configuration{}, fmt.Errorf("%w: positionRetention must be at least 1 day", ErrBadRequest)
+		}
+		dbConfig.PositionRetention = *apiConfig.PositionRetention
+	}
 	af, err := json.Marshal(apiConfig.AssetFilter)
 	if err != nil {
 		return appdb.Configuration{}, fmt.Errorf("marshalling assetFilter: %v", err)
 	}
 	dbConfig.AssetFilter = null.JSONFrom(af)
+	lf, err := json.Marshal(apiConfig.LocationFilter)
+	if err != nil {
+		return appdb.Configuration{}, fmt.Errorf("marshalling locationFilter: %v", err)
+	}
+	dbConfig.LocationFilter = null.JSONFrom(lf)
 	dbConfig.Active = null.BoolFromPtr(apiConfig.Active)
 	if apiConfig.ProjectIDs != nil {
 		dbConfig.ProjectIds = *apiConfig.ProjectIDs
@@ -130,10 +183,39 @@ func apiConfigFromDbConfig(dbConfig *appdb.Configuration) (apiConfig apiserver.C
 		}
 		apiConfig.AssetFilter = af
 	}
+	if dbConfig.LocationFilter.Valid {
+		var lf [][]apiserver.FilterRule
+		if err := json.Unmarshal(dbConfig.LocationFilter.JSON, &lf); err != nil {
+			return apiserver.Configuration{}, fmt.Errorf("unmarshalling locationFilter: %v", err)
+		}
+		apiConfig.LocationFilter = lf
+	}
 	apiConfig.Active = dbConfig.Active.Ptr()
 	apiConfig.ProjectIDs = common.Ptr[[]string](dbConfig.ProjectIds)
 	apiConfig.AbsoluteX = dbConfig.AbsoluteX
 	apiConfig.AbsoluteY = dbConfig.AbsoluteY
+	apiConfig.PositionRetention = &dbConfig.PositionRetention
+	if dbConfig.ProjectMappings.Valid {
+		var pm []apiserver.ProjectMapping
+		if err := json.Unmarshal(dbConfig.ProjectMappings.JSON, &pm); err != nil {
+			return apiserver.Configuration{}, fmt.Errorf("unmarshalling projectMappings: %v", err)
+		}
+		apiConfig.ProjectMappings = pm
+	}
+	if dbConfig.AlarmThresholds.Valid {
+		var at []apiserver.AlarmThreshold
+		if err := json.Unmarshal(dbConfig.AlarmThresholds.JSON, &at); err != nil {
+			return apiserver.Configuration{}, fmt.Errorf("unmarshalling alarmThresholds: %v", err)
+		}
+		apiConfig.AlarmThresholds = at
+	}
+	if dbConfig.FirmwareVersions.Valid {
+		var fv map[string]string
+		if err := json.Unmarshal(dbConfig.FirmwareVersions.JSON, &fv); err != nil {
+			return apiserver.Configuration{}, fmt.Errorf("unmarshalling firmwareVersions: %v", err)
+		}
+		apiConfig.FirmwareVersions = fv
+	}
 	return apiConfig, nil
 }
 
@@ -154,8 +236,7 @@ func GetConfigs(ctx context.Context) ([]apiserver.Configuration, error) {
 	return apiConfigs, nil
 }
 
-func SetFloorHeight(assetId int32, height float64) error {
-	ctx := context.Background()
+func SetFloorHeight(ctx context.Context, assetId int32, height float64) error {
 	dbLocations, err := appdb.Locations(
 		appdb.LocationWhere.AssetID.EQ(null.Int32From(assetId)),
 	).UpdateAllG(ctx, appdb.M{
@@ -236,6 +317,79 @@ func InsertDevice(ctx context.Context, config apiserver.Configuration, projId st
 	return dbTag.InsertG(ctx, boil.Infer())
 }
 
+func GetTags(ctx context.Context, config apiserver.Configuration) ([]*appdb.Tag, error) {
+	return appdb.Tags(
+		appdb.TagWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.TagWhere.AssetID.IsNotNull(),
+	).AllG(ctx)
+}
+
+// GetTagByAssetId returns the device created as the asset, or nil if the asset is not a device of the app.
+func GetTagByAssetId(ctx context.Context, assetId int32) (*appdb.Tag, error) {
+	dbTag, err := appdb.Tags(
+		appdb.TagWhere.AssetID.EQ(null.Int32From(assetId)),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetching tag with assetId %v: %v", assetId, err)
+	}
+	return dbTag, nil
+}
+
+// GetAssetGlobalId returns the global asset ID of a location or device asset created by the app.
+// It returns nil if the asset was not created by the app.
+func GetAssetGlobalId(ctx context.Context, assetId int32) (*string, error) {
+	dbLocation, err := appdb.Locations(
+		appdb.LocationWhere.AssetID.EQ(null.Int32From(assetId)),
+	).OneG(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("fetching location with assetId %v: %v", assetId, err)
+	}
+	if dbLocation != nil {
+		return &dbLocation.GlobalAssetID, nil
+	}
+	dbTag, err := appdb.Tags(
+		appdb.TagWhere.AssetID.EQ(null.Int32From(assetId)),
+	).OneG(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("fetching tag with assetId %v: %v", assetId, err)
+	}
+	if dbTag != nil {
+		return &dbTag.GlobalAssetID, nil
+	}
+	return nil, nil
+}
+
+// GetAlarmRule returns the alarm rule the app created for the asset attribute, or nil if there is
+// none.
+func GetAlarmRule(ctx context.Context, assetId int32, attribute string) (*appdb.AlarmRule, error) {
+	dbRule, err := appdb.AlarmRules(
+		appdb.AlarmRuleWhere.AssetID.EQ(assetId),
+		appdb.AlarmRuleWhere.Attribute.EQ(attribute),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return dbRule, nil
+}
+
+func UpsertAlarmRule(ctx context.Context, rule *appdb.AlarmRule) error {
+	return rule.UpsertG(ctx, true, []string{
+		appdb.AlarmRuleColumns.AssetID,
+		appdb.AlarmRuleColumns.Attribute,
+	}, boil.Infer(), boil.Infer())
+}
+
+func DeleteAlarmRule(ctx context.Context, rule *appdb.AlarmRule) error {
+	_, err := rule.DeleteG(ctx)
+	return err
+}
+
 func SetConfigActiveState(ctx context.Context, config apiserver.Configuration, state bool) (int64, error) {
 	return appdb.Configurations(
 		appdb.ConfigurationWhere.ID.EQ(null.Int64FromPtr(config.Id).Int64),
@@ -244,6 +398,14 @@ func SetConfigActiveState(ctx context.Context, config apiserver.Configuration, s
 	})
 }
 
+// SetAllConfigsInactive marks all configurations inactive, e.g. when the app stops.
+func SetAllConfigsInactive(ctx context.Context) error {
+	_, err := appdb.Configurations().UpdateAllG(ctx, appdb.M{
+		appdb.ConfigurationColumns.Active: false,
+	})
+	return err
+}
+
 func ProjIds(config apiserver.Configuration) []string {
 	if config.ProjectIDs == nil {
 		return []string{}
@@ -258,3 +420,9 @@ func IsConfigActive(config apiserver.Configuration) bool {
 func IsConfigEnabled(config apiserver.Configuration) bool {
 	return config.Enable == nil || *config.Enable
 }
+
+// Ping checks that the database can be queried.
+func Ping(ctx context.Context) error {
+	var one int
+	return boil.GetContextDB().QueryRowContext(ctx, "select 1").Scan(&one)
+}
@@ -0,0 +1,98 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func GetFloorPlanByLocation(ctx context.Context, locationID int64) (*appdb.FloorPlan, error) {
+	floorPlan, err := appdb.FindFloorPlanG(ctx, locationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return floorPlan, err
+}
+
+func UpsertFloorPlan(ctx context.Context, floorPlan *appdb.FloorPlan) error {
+	return floorPlan.UpsertG(ctx, true, []string{appdb.FloorPlanColumns.LocationID}, boil.Infer(), boil.Infer())
+}
+
+// GetFloorPlan returns the floor plan including the image of a Kontakt.io floor.
+func GetFloorPlan(ctx context.Context, configID int64, floorID int32) (*appdb.FloorPlan, error) {
+	floorPlan, err := appdb.FloorPlans(
+		appdb.FloorPlanWhere.ConfigurationID.EQ(configID),
+		appdb.FloorPlanWhere.FloorID.EQ(floorID),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrBadRequest
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetching floor plan from database: %v", err)
+	}
+	return floorPlan, nil
+}
+
+// GetFloorPlans returns the floor plans of a configuration without the images.
+func GetFloorPlans(ctx context.Context, configID int64) ([]apiserver.FloorPlan, error) {
+	dbFloorPlans, err := appdb.FloorPlans(
+		qm.Select(
+			appdb.FloorPlanColumns.LocationID,
+			appdb.FloorPlanColumns.ConfigurationID,
+			appdb.FloorPlanColumns.FloorID,
+			appdb.FloorPlanColumns.ContentType,
+			appdb.FloorPlanColumns.ImageWidth,
+			appdb.FloorPlanColumns.ImageHeight,
+			appdb.FloorPlanColumns.Scale,
+			appdb.FloorPlanColumns.OriginX,
+			appdb.FloorPlanColumns.OriginY,
+			appdb.FloorPlanColumns.Rotation,
+			appdb.FloorPlanColumns.UpdatedAt,
+		),
+		appdb.FloorPlanWhere.ConfigurationID.EQ(configID),
+		qm.OrderBy(appdb.FloorPlanColumns.FloorID),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching floor plans from database: %v", err)
+	}
+	floorPlans := make([]apiserver.FloorPlan, 0, len(dbFloorPlans))
+	for _, dbFloorPlan := range dbFloorPlans {
+		floorPlans = append(floorPlans, ApiFloorPlanFromDbFloorPlan(dbFloorPlan))
+	}
+	return floorPlans, nil
+}
+
+func ApiFloorPlanFromDbFloorPlan(dbFloorPlan *appdb.FloorPlan) apiserver.FloorPlan {
+	return apiserver.FloorPlan{
+		FloorId:     dbFloorPlan.FloorID,
+		HasImage:    dbFloorPlan.ContentType.Valid,
+		ImageWidth:  dbFloorPlan.ImageWidth,
+		ImageHeight: dbFloorPlan.ImageHeight,
+		Scale:       dbFloorPlan.Scale,
+		OriginX:     dbFloorPlan.OriginX,
+		OriginY:     dbFloorPlan.OriginY,
+		Rotation:    dbFloorPlan.Rotation,
+		UpdatedAt:   dbFloorPlan.UpdatedAt,
+	}
+}
@@ -0,0 +1,95 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"strconv"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetFloorHeights lists the floor locations having an asset together with their heights.
+// If configID is 0, the floors of all configurations are listed.
+func GetFloorHeights(ctx context.Context, configID int64, floorAssetType string) ([]apiserver.FloorHeight, error) {
+	mods := []qm.QueryMod{
+		appdb.LocationWhere.GlobalAssetID.LIKE(floorAssetType + "%"),
+		appdb.LocationWhere.AssetID.IsNotNull(),
+		qm.OrderBy(appdb.LocationColumns.ConfigurationID + ", " + appdb.LocationColumns.ProjectID + ", " + appdb.LocationColumns.AssetID),
+	}
+	if configID != 0 {
+		mods = append(mods, appdb.LocationWhere.ConfigurationID.EQ(configID))
+	}
+	dbLocations, err := appdb.Locations(mods...).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching floor locations from database: %v", err)
+	}
+	floorHeights := make([]apiserver.FloorHeight, 0, len(dbLocations))
+	for _, dbLocation := range dbLocations {
+		floorHeight, err := ApiFloorHeightFromDbLocation(dbLocation, floorAssetType)
+		if err != nil {
+			return nil, err
+		}
+		floorHeights = append(floorHeights, floorHeight)
+	}
+	return floorHeights, nil
+}
+
+// GetFloorLocationByAsset returns the location of a floor asset. It fails with ErrBadRequest if the
+// asset is not a floor of a known configuration.
+func GetFloorLocationByAsset(ctx context.Context, assetId int32, floorAssetType string) (*appdb.Location, error) {
+	dbLocation, err := appdb.Locations(
+		appdb.LocationWhere.AssetID.EQ(null.Int32From(assetId)),
+	).OneG(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("fetching location with assetId %v: %v", assetId, err)
+	}
+	if dbLocation == nil {
+		return nil, fmt.Errorf("%w: asset %v is not a Kontakt.io location", ErrBadRequest, assetId)
+	}
+	if !strings.HasPrefix(dbLocation.GlobalAssetID, floorAssetType) {
+		return nil, fmt.Errorf("%w: asset %v is not a %s", ErrBadRequest, assetId, floorAssetType)
+	}
+	exists, err := appdb.ConfigurationExistsG(ctx, dbLocation.ConfigurationID)
+	if err != nil {
+		return nil, fmt.Errorf("checking configuration %v: %v", dbLocation.ConfigurationID, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: asset %v belongs to unknown configuration %v", ErrBadRequest, assetId, dbLocation.ConfigurationID)
+	}
+	return dbLocation, nil
+}
+
+func ApiFloorHeightFromDbLocation(dbLocation *appdb.Location, floorAssetType string) (apiserver.FloorHeight, error) {
+	floorId, err := strconv.ParseInt(strings.TrimPrefix(dbLocation.GlobalAssetID, floorAssetType), 10, 32)
+	if err != nil {
+		return apiserver.FloorHeight{}, fmt.Errorf("parsing floor ID of location %v: %v", dbLocation.ID, err)
+	}
+	return apiserver.FloorHeight{
+		AssetId:         dbLocation.AssetID.Int32,
+		ConfigurationId: dbLocation.ConfigurationID,
+		ProjectId:       dbLocation.ProjectID,
+		FloorId:         int32(floorId),
+		Height:          dbLocation.FloorHeight.Ptr(),
+	}, nil
+}
@@ -55,5 +55,164 @@ create table if not exists kontakt_io.tag
 	primary key (configuration_id, project_id, global_asset_id)
 );
 
+-- Zone is a geofence defined by a polygon on a floor or by a set of rooms
+-- Should be editable by eliona frontend.
+create table if not exists kontakt_io.zone
+(
+	id               bigserial primary key,
+	configuration_id bigint    not null references kontakt_io.configuration(id) on delete cascade,
+	name             text      not null,
+	floor_id         integer,
+	polygon          json,
+	room_ids         integer[],
+	dwell_threshold  integer,
+	allowed_filter   json,
+	raise_alarm      boolean   not null default false
+);
+
+-- Zone presence holds the devices currently inside a zone
+-- Should be read-only by eliona frontend.
+create table if not exists kontakt_io.zone_presence
+(
+	zone_id          bigint      not null references kontakt_io.zone(id) on delete cascade,
+	device_id        text        not null,
+	device_type      text        not null,
+	entered_at       timestamptz not null,
+	last_seen_at     timestamptz not null,
+	dwell_exceeded   boolean     not null default false,
+	unauthorized     boolean     not null default false,
+	primary key (zone_id, device_id)
+);
+
+-- Alarm rule created by the app in Eliona for an asset attribute, with the thresholds it was created with
+-- Should be read-only by eliona frontend.
+create table if not exists kontakt_io.alarm_rule
+(
+	configuration_id bigint  not null references kontakt_io.configuration(id) on delete cascade,
+	asset_id         integer not null,
+	attribute        text    not null,
+	alarm_rule_id    integer not null,
+	low              double precision,
+	high             double precision,
+	primary key (asset_id, attribute)
+);
+
+-- Room visit is one stay of a tracked device in a room
+-- Should be read-only by eliona frontend.
+create table if not exists kontakt_io.room_visit
+(
+	id               bigserial primary key,
+	configuration_id bigint      not null references kontakt_io.configuration(id) on delete cascade,
+	device_id        text        not null,
+	room_id          integer     not null,
+	entered_at       timestamptz not null,
+	last_seen_at     timestamptz not null,
+	exited_at        timestamptz,
+	duration         integer     not null default 0
+);
+
+create index if not exists room_visit_configuration_id_entered_at_idx on kontakt_io.room_visit (configuration_id, entered_at);
+
+-- Position is one recorded position of a tracked device, kept for the retention period of the configuration
+-- Should be read-only by eliona frontend.
+create table if not exists kontakt_io.position
+(
+	configuration_id bigint      not null references kontakt_io.configuration(id) on delete cascade,
+	device_id        text        not null,
+	recorded_at      timestamptz not null,
+	floor_id         integer,
+	room_id          integer,
+	x                float       not null,
+	y                float       not null,
+	primary key (configuration_id, device_id, recorded_at)
+);
+
+-- Floor plan is the plan image and its geometry imported from Kontakt.io for a floor location
+-- Should be read-only by eliona frontend.
+create table if not exists kontakt_io.floor_plan
+(
+	location_id      bigint      primary key references kontakt_io.location(id) on delete cascade,
+	configuration_id bigint      not null references kontakt_io.configuration(id) on delete cascade,
+	floor_id         integer     not null,
+	image_url        text,
+	image            bytea,
+	content_type     text,
+	image_width      integer     not null default 0,
+	image_height     integer     not null default 0,
+	scale            float       not null default 0,
+	origin_x         float       not null default 0,
+	origin_y         float       not null default 0,
+	rotation         float       not null default 0,
+	updated_at       timestamptz not null
+);
+
+-- Device setting is a setting changed through an Eliona output, pushed to the Kontakt.io device configuration
+-- Should be read-only by eliona frontend.
+create table if not exists kontakt_io.device_setting
+(
+	configuration_id bigint      not null references kontakt_io.configuration(id) on delete cascade,
+	device_id        text        not null,
+	attribute        text        not null,
+	value            text        not null,
+	state            text        not null,
+	error            text,
+	requested_at     timestamptz not null,
+	pushed_at        timestamptz,
+	applied_at       timestamptz,
+	primary key (configuration_id, device_id, attribute)
+);
+
+-- Battery level is one sampled battery level of a device, used to forecast the battery depletion
+-- Should be read-only by eliona frontend.
+create table if not exists kontakt_io.battery_level
+(
+	configuration_id bigint      not null references kontakt_io.configuration(id) on delete cascade,
+	device_id        text        not null,
+	recorded_at      timestamptz not null,
+	battery_level    integer     not null,
+	primary key (configuration_id, device_id, recorded_at)
+);
+
+-- Device inventory holds all Kontakt.io devices of a configuration, including devices without assets
+-- Should be read-only by eliona frontend.
+create table if not exists kontakt_io.device_inventory
+(
+	configuration_id   bigint      not null references kontakt_io.configuration(id) on delete cascade,
+	device_id          text        not null,
+	name               text        not null,
+	product            text        not null,
+	asset_type         text,
+	firmware           text,
+	matches_filter     boolean     not null default false,
+	last_seen_at       timestamptz,
+	updated_at         timestamptz not null,
+	primary key (configuration_id, device_id)
+);
+
+-- Sync runs record every collection cycle of a configuration with its counts and the error of each phase
+-- Should be read-only by eliona frontend.
+create table if not exists kontakt_io.sync_run
+(
+	id               bigserial   primary key,
+	configuration_id bigint      not null references kontakt_io.configuration(id) on delete cascade,
+	scope            text        not null default 'all',
+	requested_at     timestamptz,
+	started_at       timestamptz,
+	finished_at      timestamptz,
+	devices_fetched  integer     not null default 0,
+	assets_created   integer     not null default 0,
+	data_points      integer     not null default 0,
+	location_error   text,
+	device_error     text
+);
+
+create index if not exists sync_run_configuration_started_at on kontakt_io.sync_run (configuration_id, started_at desc);
+
+alter table kontakt_io.configuration add column if not exists position_retention integer not null default 7;
+alter table kontakt_io.configuration add column if not exists alarm_thresholds json;
+alter table kontakt_io.configuration add column if not exists firmware_versions json;
+alter table kontakt_io.configuration add column if not exists location_filter json;
+alter table kontakt_io.configuration add column if not exists project_mappings json;
+
 -- Makes the new objects available for all other init steps
 commit;
@@ -0,0 +1,151 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func GetDeviceInventory(ctx context.Context, configID int64) ([]*appdb.DeviceInventory, error) {
+	return appdb.DeviceInventories(
+		appdb.DeviceInventoryWhere.ConfigurationID.EQ(configID),
+		qm.OrderBy(appdb.DeviceInventoryColumns.DeviceID),
+	).AllG(ctx)
+}
+
+func UpsertDeviceInventory(ctx context.Context, device *appdb.DeviceInventory) error {
+	return device.UpsertG(ctx, true, []string{
+		appdb.DeviceInventoryColumns.ConfigurationID,
+		appdb.DeviceInventoryColumns.DeviceID,
+	}, boil.Infer(), boil.Infer())
+}
+
+// DeleteDeviceInventoryExcept removes the devices no longer known to Kontakt.io.
+func DeleteDeviceInventoryExcept(ctx context.Context, configID int64, deviceIDs []string) (int64, error) {
+	return appdb.DeviceInventories(
+		appdb.DeviceInventoryWhere.ConfigurationID.EQ(configID),
+		appdb.DeviceInventoryWhere.DeviceID.NIN(deviceIDs),
+	).DeleteAllG(ctx)
+}
+
+// GetInventoryDevices lists the devices of the inventory with the assets created for them.
+func GetInventoryDevices(ctx context.Context, configID int64) ([]apiserver.InventoryDevice, error) {
+	devices, err := GetDeviceInventory(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	assets, err := getAssetMappings(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]apiserver.InventoryDevice, 0, len(devices))
+	for _, device := range devices {
+		inventoryDevice := apiserver.InventoryDevice{
+			DeviceId:      device.DeviceID,
+			Name:          device.Name,
+			Product:       device.Product,
+			AssetType:     device.AssetType.String,
+			Firmware:      device.Firmware.String,
+			MatchesFilter: device.MatchesFilter,
+			LastSeenAt:    device.LastSeenAt.Ptr(),
+			Assets:        []apiserver.AssetMapping{},
+		}
+		if device.AssetType.Valid {
+			if mapped, ok := assets[device.AssetType.String+device.DeviceID]; ok {
+				inventoryDevice.Assets = mapped
+			}
+		}
+		result = append(result, inventoryDevice)
+	}
+	return result, nil
+}
+
+func getAssetMappings(ctx context.Context, configID int64) (map[string][]apiserver.AssetMapping, error) {
+	tags, err := appdb.Tags(
+		appdb.TagWhere.ConfigurationID.EQ(configID),
+		appdb.TagWhere.AssetID.IsNotNull(),
+		qm.OrderBy(appdb.TagColumns.ProjectID),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	assets := make(map[string][]apiserver.AssetMapping)
+	for _, tag := range tags {
+		assets[tag.GlobalAssetID] = append(assets[tag.GlobalAssetID], apiserver.AssetMapping{
+			ProjectId: tag.ProjectID,
+			AssetId:   tag.AssetID.Int32,
+		})
+	}
+	return assets, nil
+}
+
+// GetInventoryLocations lists the Kontakt.io buildings, floors and rooms of the configuration with
+// the assets created for them.
+func GetInventoryLocations(ctx context.Context, configID int64) ([]apiserver.InventoryLocation, error) {
+	locations, err := appdb.Locations(
+		appdb.LocationWhere.ConfigurationID.EQ(configID),
+		qm.OrderBy(appdb.LocationColumns.ProjectID),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byGlobalAssetId := make(map[string]*apiserver.InventoryLocation)
+	for _, location := range locations {
+		inventoryLocation, ok := byGlobalAssetId[location.GlobalAssetID]
+		if !ok {
+			// Global asset IDs are the asset type followed by the Kontakt.io location ID.
+			assetType := strings.TrimRight(location.GlobalAssetID, "0123456789")
+			locationId, err := strconv.ParseInt(strings.TrimPrefix(location.GlobalAssetID, assetType), 10, 32)
+			if err != nil {
+				// Not a Kontakt.io location, e.g. the root asset.
+				continue
+			}
+			inventoryLocation = &apiserver.InventoryLocation{
+				LocationId:  int32(locationId),
+				AssetType:   assetType,
+				RoomNumber:  location.RoomNumber.Ptr(),
+				FloorHeight: location.FloorHeight.Ptr(),
+				Assets:      []apiserver.AssetMapping{},
+			}
+			byGlobalAssetId[location.GlobalAssetID] = inventoryLocation
+		}
+		if location.AssetID.Valid {
+			inventoryLocation.Assets = append(inventoryLocation.Assets, apiserver.AssetMapping{
+				ProjectId: location.ProjectID,
+				AssetId:   location.AssetID.Int32,
+			})
+		}
+	}
+	result := make([]apiserver.InventoryLocation, 0, len(byGlobalAssetId))
+	for _, location := range byGlobalAssetId {
+		result = append(result, *location)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].AssetType != result[j].AssetType {
+			return result[i].AssetType < result[j].AssetType
+		}
+		return result[i].LocationId < result[j].LocationId
+	})
+	return result, nil
+}
@@ -0,0 +1,78 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// InsertPosition records a position. A position already recorded for the same device and time is ignored.
+func InsertPosition(ctx context.Context, position *appdb.Position) error {
+	return position.UpsertG(ctx, false, []string{
+		appdb.PositionColumns.ConfigurationID,
+		appdb.PositionColumns.DeviceID,
+		appdb.PositionColumns.RecordedAt,
+	}, boil.None(), boil.Infer())
+}
+
+func DeletePositionsBefore(ctx context.Context, configID int64, before time.Time) (int64, error) {
+	return appdb.Positions(
+		appdb.PositionWhere.ConfigurationID.EQ(configID),
+		appdb.PositionWhere.RecordedAt.LT(before),
+	).DeleteAllG(ctx)
+}
+
+func GetTrajectory(ctx context.Context, configID int64, deviceID string, from, to time.Time) ([]apiserver.TrajectoryPoint, error) {
+	positions, err := appdb.Positions(
+		appdb.PositionWhere.ConfigurationID.EQ(configID),
+		appdb.PositionWhere.DeviceID.EQ(deviceID),
+		appdb.PositionWhere.RecordedAt.GTE(from),
+		appdb.PositionWhere.RecordedAt.LTE(to),
+		qm.OrderBy(appdb.PositionColumns.RecordedAt),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	points := make([]apiserver.TrajectoryPoint, 0, len(positions))
+	for _, position := range positions {
+		points = append(points, apiserver.TrajectoryPoint{
+			Timestamp: position.RecordedAt,
+			X:         position.X,
+			Y:         position.Y,
+			FloorId:   position.FloorID.Ptr(),
+			RoomId:    position.RoomID.Ptr(),
+		})
+	}
+	return points, nil
+}
+
+// GetFloorPositions returns the positions of all devices recorded on a floor within the time range.
+func GetFloorPositions(ctx context.Context, configID int64, floorID int32, from, to time.Time) ([]*appdb.Position, error) {
+	return appdb.Positions(
+		qm.Select(appdb.PositionColumns.X, appdb.PositionColumns.Y),
+		appdb.PositionWhere.ConfigurationID.EQ(configID),
+		appdb.PositionWhere.FloorID.EQ(null.Int32From(floorID)),
+		appdb.PositionWhere.RecordedAt.GTE(from),
+		appdb.PositionWhere.RecordedAt.LTE(to),
+	).AllG(ctx)
+}
@@ -0,0 +1,74 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"kontakt-io/appdb"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// States of a device setting on its way to the device.
+const (
+	DeviceSettingRequested = "requested"
+	DeviceSettingPending   = "pending"
+	DeviceSettingApplied   = "applied"
+	DeviceSettingFailed    = "failed"
+)
+
+// UpsertDeviceSetting stores a setting change, replacing earlier changes of the same setting.
+func UpsertDeviceSetting(ctx context.Context, setting *appdb.DeviceSetting) error {
+	return setting.UpsertG(ctx, true, []string{
+		appdb.DeviceSettingColumns.ConfigurationID,
+		appdb.DeviceSettingColumns.DeviceID,
+		appdb.DeviceSettingColumns.Attribute,
+	}, boil.Infer(), boil.Infer())
+}
+
+// GetDeviceSetting returns the last change of a device setting, or nil if there is none.
+func GetDeviceSetting(ctx context.Context, configID int64, deviceID string, attribute string) (*appdb.DeviceSetting, error) {
+	setting, err := appdb.FindDeviceSettingG(ctx, configID, deviceID, attribute)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return setting, err
+}
+
+func UpdateDeviceSetting(ctx context.Context, setting *appdb.DeviceSetting) error {
+	_, err := setting.UpdateG(ctx, boil.Infer())
+	return err
+}
+
+// GetUnappliedDeviceSettings returns the settings of a configuration not yet applied by their devices.
+func GetUnappliedDeviceSettings(ctx context.Context, configID int64) ([]*appdb.DeviceSetting, error) {
+	return appdb.DeviceSettings(
+		appdb.DeviceSettingWhere.ConfigurationID.EQ(configID),
+		appdb.DeviceSettingWhere.State.IN([]string{DeviceSettingRequested, DeviceSettingPending}),
+	).AllG(ctx)
+}
+
+// GetDeviceSettings returns the settings of a device, one for each attribute.
+func GetDeviceSettings(ctx context.Context, configID int64, deviceID string) ([]*appdb.DeviceSetting, error) {
+	return appdb.DeviceSettings(
+		appdb.DeviceSettingWhere.ConfigurationID.EQ(configID),
+		appdb.DeviceSettingWhere.DeviceID.EQ(deviceID),
+		qm.OrderBy(appdb.DeviceSettingColumns.Attribute),
+	).AllG(ctx)
+}
@@ -0,0 +1,130 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"kontakt-io/appdb"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func InsertSyncRun(ctx context.Context, run *appdb.SyncRun) error {
+	return run.InsertG(ctx, boil.Infer())
+}
+
+func UpdateSyncRun(ctx context.Context, run *appdb.SyncRun) error {
+	_, err := run.UpdateG(ctx, boil.Infer())
+	return err
+}
+
+// DeleteSyncRunsBefore deletes the runs started before the given time, and the queued runs
+// requested before it.
+func DeleteSyncRunsBefore(ctx context.Context, configID int64, before time.Time) (int64, error) {
+	return appdb.SyncRuns(
+		appdb.SyncRunWhere.ConfigurationID.EQ(configID),
+		qm.Where("coalesce("+appdb.SyncRunColumns.StartedAt+", "+appdb.SyncRunColumns.RequestedAt+") < ?", before),
+	).DeleteAllG(ctx)
+}
+
+// GetSyncRuns returns the latest sync runs of the configuration, newest first. Queued runs, which
+// haven't started yet, come first.
+func GetSyncRuns(ctx context.Context, configID int64, limit int) (appdb.SyncRunSlice, error) {
+	return appdb.SyncRuns(
+		appdb.SyncRunWhere.ConfigurationID.EQ(configID),
+		qm.OrderBy(appdb.SyncRunColumns.StartedAt+" desc nulls first, "+appdb.SyncRunColumns.ID+" desc"),
+		qm.Limit(limit),
+	).AllG(ctx)
+}
+
+// GetLastFinishedSyncRun returns the latest finished sync run, or nil if there is none.
+func GetLastFinishedSyncRun(ctx context.Context, configID int64) (*appdb.SyncRun, error) {
+	runs, err := appdb.SyncRuns(
+		appdb.SyncRunWhere.ConfigurationID.EQ(configID),
+		appdb.SyncRunWhere.FinishedAt.IsNotNull(),
+		qm.OrderBy(appdb.SyncRunColumns.FinishedAt+" desc"),
+		qm.Limit(1),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return nil, nil
+	}
+	return runs[0], nil
+}
+
+// GetLastSuccessfulSyncRun returns the latest sync run finished without errors, or nil if there
+// is none.
+func GetLastSuccessfulSyncRun(ctx context.Context, configID int64) (*appdb.SyncRun, error) {
+	runs, err := appdb.SyncRuns(
+		appdb.SyncRunWhere.ConfigurationID.EQ(configID),
+		appdb.SyncRunWhere.FinishedAt.IsNotNull(),
+		appdb.SyncRunWhere.LocationError.IsNull(),
+		appdb.SyncRunWhere.DeviceError.IsNull(),
+		qm.OrderBy(appdb.SyncRunColumns.StartedAt+" desc"),
+		qm.Limit(1),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return nil, nil
+	}
+	return runs[0], nil
+}
+
+// GetSyncRun returns the sync run of the configuration, or nil if there is none with the ID.
+func GetSyncRun(ctx context.Context, configID int64, runID int64) (*appdb.SyncRun, error) {
+	run, err := appdb.SyncRuns(
+		appdb.SyncRunWhere.ConfigurationID.EQ(configID),
+		appdb.SyncRunWhere.ID.EQ(runID),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return run, err
+}
+
+// GetQueuedSyncRun returns the oldest sync run of the configuration that was requested but hasn't
+// started yet, or nil if there is none.
+func GetQueuedSyncRun(ctx context.Context, configID int64) (*appdb.SyncRun, error) {
+	run, err := appdb.SyncRuns(
+		appdb.SyncRunWhere.ConfigurationID.EQ(configID),
+		appdb.SyncRunWhere.StartedAt.IsNull(),
+		qm.OrderBy(appdb.SyncRunColumns.ID),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return run, err
+}
+
+// FinishInterruptedSyncRuns marks the sync runs that started but never finished, because the app
+// stopped during the run, as finished with an error.
+func FinishInterruptedSyncRuns(ctx context.Context) (int64, error) {
+	return appdb.SyncRuns(
+		appdb.SyncRunWhere.StartedAt.IsNotNull(),
+		appdb.SyncRunWhere.FinishedAt.IsNull(),
+	).UpdateAllG(ctx, appdb.M{
+		appdb.SyncRunColumns.FinishedAt:  time.Now(),
+		appdb.SyncRunColumns.DeviceError: "interrupted, the app stopped during the run",
+	})
+}
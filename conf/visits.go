@@ -0,0 +1,85 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+func GetOpenRoomVisits(ctx context.Context, config apiserver.Configuration) ([]*appdb.RoomVisit, error) {
+	return appdb.RoomVisits(
+		appdb.RoomVisitWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.RoomVisitWhere.ExitedAt.IsNull(),
+	).AllG(ctx)
+}
+
+func InsertRoomVisit(ctx context.Context, visit *appdb.RoomVisit) error {
+	return visit.InsertG(ctx, boil.Infer())
+}
+
+func UpdateRoomVisit(ctx context.Context, visit *appdb.RoomVisit) error {
+	_, err := visit.UpdateG(ctx, boil.Infer())
+	return err
+}
+
+// DwellTime is the time spent in a room or by a device, aggregated over a time range.
+type DwellTime struct {
+	Key          string `boil:"key"`
+	Visits       int64  `boil:"visits"`
+	DwellSeconds int64  `boil:"dwell_seconds"`
+}
+
+// GetDwellTimes aggregates the room visits overlapping the time range. The visits are grouped
+// either by room or by device, only the part of a visit inside the time range is counted.
+func GetDwellTimes(ctx context.Context, configID int64, from, to time.Time, byDevice bool) ([]DwellTime, error) {
+	groupBy := appdb.RoomVisitColumns.RoomID
+	if byDevice {
+		groupBy = appdb.RoomVisitColumns.DeviceID
+	}
+	var dwellTimes []DwellTime
+	err := queries.Raw(fmt.Sprintf(`
+		select %[1]s::text as key,
+			count(*) as visits,
+			coalesce(sum(extract(epoch from least(coalesce(exited_at, last_seen_at), $3) - greatest(entered_at, $2))), 0)::bigint as dwell_seconds
+		from kontakt_io.room_visit
+		where configuration_id = $1
+			and entered_at < $3
+			and coalesce(exited_at, last_seen_at) > $2
+		group by %[1]s
+		order by %[1]s`, groupBy),
+		configID, from, to,
+	).BindG(ctx, &dwellTimes)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating room visits: %v", err)
+	}
+	return dwellTimes, nil
+}
+
+func GetRoomLocations(ctx context.Context, config apiserver.Configuration, roomAssetType string) ([]*appdb.Location, error) {
+	return appdb.Locations(
+		appdb.LocationWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.LocationWhere.GlobalAssetID.LIKE(roomAssetType+"%"),
+		appdb.LocationWhere.AssetID.IsNotNull(),
+	).AllG(ctx)
+}
@@ -0,0 +1,216 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func GetZones(ctx context.Context, configID int64) ([]apiserver.Zone, error) {
+	var mods []qm.QueryMod
+	if configID != 0 {
+		mods = append(mods, appdb.ZoneWhere.ConfigurationID.EQ(configID))
+	}
+	dbZones, err := appdb.Zones(mods...).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching zones from database: %v", err)
+	}
+	apiZones := []apiserver.Zone{}
+	for _, dbZone := range dbZones {
+		apiZone, err := apiZoneFromDbZone(dbZone)
+		if err != nil {
+			return nil, fmt.Errorf("creating API zone from DB zone: %v", err)
+		}
+		apiZones = append(apiZones, apiZone)
+	}
+	return apiZones, nil
+}
+
+func GetZone(ctx context.Context, zoneID int64) (*apiserver.Zone, error) {
+	dbZone, err := appdb.Zones(
+		appdb.ZoneWhere.ID.EQ(zoneID),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrBadRequest
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetching zone from database: %v", err)
+	}
+	apiZone, err := apiZoneFromDbZone(dbZone)
+	if err != nil {
+		return nil, fmt.Errorf("creating API zone from DB zone: %v", err)
+	}
+	return &apiZone, nil
+}
+
+func InsertZone(ctx context.Context, zone apiserver.Zone) (apiserver.Zone, error) {
+	if err := validateZone(ctx, zone); err != nil {
+		return apiserver.Zone{}, err
+	}
+	dbZone, err := dbZoneFromApiZone(zone)
+	if err != nil {
+		return apiserver.Zone{}, fmt.Errorf("creating DB zone from API zone: %v", err)
+	}
+	if err := dbZone.InsertG(ctx, boil.Infer()); err != nil {
+		return apiserver.Zone{}, fmt.Errorf("inserting DB zone: %v", err)
+	}
+	zone.Id = &dbZone.ID
+	return zone, nil
+}
+
+func UpsertZone(ctx context.Context, zone apiserver.Zone) (apiserver.Zone, error) {
+	if err := validateZone(ctx, zone); err != nil {
+		return apiserver.Zone{}, err
+	}
+	dbZone, err := dbZoneFromApiZone(zone)
+	if err != nil {
+		return apiserver.Zone{}, fmt.Errorf("creating DB zone from API zone: %v", err)
+	}
+	if err := dbZone.UpsertG(ctx, true, []string{"id"}, boil.Blacklist("id"), boil.Infer()); err != nil {
+		return apiserver.Zone{}, fmt.Errorf("upserting DB zone: %v", err)
+	}
+	return zone, nil
+}
+
+func DeleteZone(ctx context.Context, zoneID int64) error {
+	count, err := appdb.Zones(
+		appdb.ZoneWhere.ID.EQ(zoneID),
+	).DeleteAllG(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting zone from database: %v", err)
+	}
+	if count == 0 {
+		return ErrBadRequest
+	}
+	return nil
+}
+
+func validateZone(ctx context.Context, zone apiserver.Zone) error {
+	if zone.Name == "" {
+		return fmt.Errorf("%w: zone name is required", ErrBadRequest)
+	}
+	hasPolygon := zone.Polygon != nil && len(*zone.Polygon) > 0
+	hasRooms := zone.RoomIds != nil && len(*zone.RoomIds) > 0
+	if hasPolygon == hasRooms {
+		return fmt.Errorf("%w: zone needs either a polygon or room IDs", ErrBadRequest)
+	}
+	if hasPolygon {
+		if zone.FloorId == nil {
+			return fmt.Errorf("%w: polygon zone needs a floor ID", ErrBadRequest)
+		}
+		if len(*zone.Polygon) < 3 {
+			return fmt.Errorf("%w: polygon needs at least 3 vertices", ErrBadRequest)
+		}
+		for _, vertex := range *zone.Polygon {
+			if len(vertex) != 2 {
+				return fmt.Errorf("%w: polygon vertices must be [x, y] pairs", ErrBadRequest)
+			}
+		}
+	}
+	exists, err := appdb.Configurations(
+		appdb.ConfigurationWhere.ID.EQ(zone.ConfigurationId),
+	).ExistsG(ctx)
+	if err != nil {
+		return fmt.Errorf("checking configuration %v: %v", zone.ConfigurationId, err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: configuration %v does not exist", ErrBadRequest, zone.ConfigurationId)
+	}
+	return nil
+}
+
+func dbZoneFromApiZone(apiZone apiserver.Zone) (dbZone appdb.Zone, err error) {
+	dbZone.ID = null.Int64FromPtr(apiZone.Id).Int64
+	dbZone.ConfigurationID = apiZone.ConfigurationId
+	dbZone.Name = apiZone.Name
+	dbZone.FloorID = null.Int32FromPtr(apiZone.FloorId)
+	if apiZone.Polygon != nil {
+		p, err := json.Marshal(apiZone.Polygon)
+		if err != nil {
+			return appdb.Zone{}, fmt.Errorf("marshalling polygon: %v", err)
+		}
+		dbZone.Polygon = null.JSONFrom(p)
+	}
+	if apiZone.RoomIds != nil {
+		for _, roomID := range *apiZone.RoomIds {
+			dbZone.RoomIds = append(dbZone.RoomIds, int64(roomID))
+		}
+	}
+	dbZone.DwellThreshold = null.Int32FromPtr(apiZone.DwellThreshold)
+	af, err := json.Marshal(apiZone.AllowedFilter)
+	if err != nil {
+		return appdb.Zone{}, fmt.Errorf("marshalling allowedFilter: %v", err)
+	}
+	dbZone.AllowedFilter = null.JSONFrom(af)
+	dbZone.RaiseAlarm = apiZone.RaiseAlarm
+	return dbZone, nil
+}
+
+func apiZoneFromDbZone(dbZone *appdb.Zone) (apiZone apiserver.Zone, err error) {
+	apiZone.Id = &dbZone.ID
+	apiZone.ConfigurationId = dbZone.ConfigurationID
+	apiZone.Name = dbZone.Name
+	apiZone.FloorId = dbZone.FloorID.Ptr()
+	if dbZone.Polygon.Valid {
+		var p [][]float64
+		if err := json.Unmarshal(dbZone.Polygon.JSON, &p); err != nil {
+			return apiserver.Zone{}, fmt.Errorf("unmarshalling polygon: %v", err)
+		}
+		apiZone.Polygon = &p
+	}
+	if dbZone.RoomIds != nil {
+		roomIDs := make([]int32, 0, len(dbZone.RoomIds))
+		for _, roomID := range dbZone.RoomIds {
+			roomIDs = append(roomIDs, int32(roomID))
+		}
+		apiZone.RoomIds = &roomIDs
+	}
+	apiZone.DwellThreshold = dbZone.DwellThreshold.Ptr()
+	if dbZone.AllowedFilter.Valid {
+		var af [][]apiserver.FilterRule
+		if err := json.Unmarshal(dbZone.AllowedFilter.JSON, &af); err != nil {
+			return apiserver.Zone{}, fmt.Errorf("unmarshalling allowedFilter: %v", err)
+		}
+		apiZone.AllowedFilter = af
+	}
+	apiZone.RaiseAlarm = dbZone.RaiseAlarm
+	return apiZone, nil
+}
+
+func GetZonePresences(ctx context.Context, zoneID int64) ([]*appdb.ZonePresence, error) {
+	return appdb.ZonePresences(
+		appdb.ZonePresenceWhere.ZoneID.EQ(zoneID),
+	).AllG(ctx)
+}
+
+func UpsertZonePresence(ctx context.Context, presence *appdb.ZonePresence) error {
+	return presence.UpsertG(ctx, true, []string{appdb.ZonePresenceColumns.ZoneID, appdb.ZonePresenceColumns.DeviceID}, boil.Infer(), boil.Infer())
+}
+
+func DeleteZonePresence(ctx context.Context, presence *appdb.ZonePresence) error {
+	_, err := presence.DeleteG(ctx)
+	return err
+}
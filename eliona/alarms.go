@@ -0,0 +1,217 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	kontaktio "kontakt-io/kontakt-io"
+	"sync"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/volatiletech/null/v8"
+)
+
+// ensureAlarmRule creates the alarm rule in Eliona unless the app already created a rule for the
+// same asset attribute. An existing rule is updated if its thresholds changed.
+func ensureAlarmRule(ctx context.Context, config apiserver.Configuration, rule api.AlarmRule) error {
+	stored, err := conf.GetAlarmRule(ctx, rule.AssetId, rule.Attribute)
+	if err != nil {
+		return fmt.Errorf("finding alarm rule: %v", err)
+	}
+	low := null.Float64FromPtr(rule.Low.Get())
+	high := null.Float64FromPtr(rule.High.Get())
+	switch {
+	case stored == nil:
+		created, _, err := client.NewClient().AlarmRulesAPI.
+			PostAlarmRule(client.AuthenticationContext()).
+			AlarmRule(rule).
+			Execute()
+		if err != nil {
+			return fmt.Errorf("creating alarm rule for asset %v attribute %s: %v", rule.AssetId, rule.Attribute, err)
+		}
+		if created.Id.Get() == nil {
+			return fmt.Errorf("cannot create alarm rule for asset %v attribute %s", rule.AssetId, rule.Attribute)
+		}
+		stored = &appdb.AlarmRule{
+			AssetID:     rule.AssetId,
+			Attribute:   rule.Attribute,
+			AlarmRuleID: *created.Id.Get(),
+		}
+		log.Debug("eliona", "Created alarm rule %v for asset %v attribute %s.", stored.AlarmRuleID, rule.AssetId, rule.Attribute)
+	case stored.Low != low || stored.High != high:
+		rule.Id = *api.NewNullableInt32(&stored.AlarmRuleID)
+		if _, _, err := client.NewClient().AlarmRulesAPI.
+			PutAlarmRuleById(client.AuthenticationContext(), stored.AlarmRuleID).
+			AlarmRule(rule).
+			Execute(); err != nil {
+			return fmt.Errorf("updating alarm rule %v for asset %v attribute %s: %v", stored.AlarmRuleID, rule.AssetId, rule.Attribute, err)
+		}
+		log.Debug("eliona", "Updated alarm rule %v for asset %v attribute %s.", stored.AlarmRuleID, rule.AssetId, rule.Attribute)
+	default:
+		return nil
+	}
+	stored.ConfigurationID = *config.Id
+	stored.Low = low
+	stored.High = high
+	if err := conf.UpsertAlarmRule(ctx, stored); err != nil {
+		return fmt.Errorf("storing alarm rule to config db: %v", err)
+	}
+	return nil
+}
+
+// removeAlarmRule deletes the alarm rule the app created for the asset attribute, if any.
+func removeAlarmRule(ctx context.Context, assetId int32, attribute string) error {
+	stored, err := conf.GetAlarmRule(ctx, assetId, attribute)
+	if err != nil {
+		return fmt.Errorf("finding alarm rule: %v", err)
+	}
+	if stored == nil {
+		return nil
+	}
+	if _, err := client.NewClient().AlarmRulesAPI.
+		DeleteAlarmRuleById(client.AuthenticationContext(), stored.AlarmRuleID).
+		Execute(); err != nil {
+		return fmt.Errorf("deleting alarm rule %v for asset %v attribute %s: %v", stored.AlarmRuleID, assetId, attribute, err)
+	}
+	if err := conf.DeleteAlarmRule(ctx, stored); err != nil {
+		return fmt.Errorf("deleting alarm rule from config db: %v", err)
+	}
+	log.Debug("eliona", "Deleted alarm rule %v for asset %v attribute %s.", stored.AlarmRuleID, assetId, attribute)
+	return nil
+}
+
+type deviceAlarmAttribute struct {
+	name    string
+	subtype api.DataSubtype
+	message map[string]interface{}
+}
+
+var batteryLevelAlarm = deviceAlarmAttribute{
+	name:    "battery_level",
+	subtype: api.SUBTYPE_STATUS,
+	message: map[string]interface{}{"de": "Batteriestand außerhalb des Bereichs", "en": "Battery level out of range"},
+}
+
+var onlineAlarm = deviceAlarmAttribute{
+	name:    onlineAttribute,
+	subtype: api.SUBTYPE_STATUS,
+	message: map[string]interface{}{"de": "Gerät offline", "en": "Device offline"},
+}
+
+var temperatureAlarm = deviceAlarmAttribute{
+	name:    "temperature",
+	subtype: api.SUBTYPE_INPUT,
+	message: map[string]interface{}{"de": "Temperatur außerhalb des Bereichs", "en": "Temperature out of range"},
+}
+
+var humidityAlarm = deviceAlarmAttribute{
+	name:    "humidity",
+	subtype: api.SUBTYPE_INPUT,
+	message: map[string]interface{}{"de": "Luftfeuchtigkeit außerhalb des Bereichs", "en": "Humidity out of range"},
+}
+
+var airQualityAlarm = deviceAlarmAttribute{
+	name:    "air_quality",
+	subtype: api.SUBTYPE_INPUT,
+	message: map[string]interface{}{"de": "Luftqualität außerhalb des Bereichs", "en": "Air quality out of range"},
+}
+
+// deviceAlarmAttributes are the attributes of each device asset type alarm rules can be created for.
+var deviceAlarmAttributes = map[string][]deviceAlarmAttribute{
+	kontaktio.BadgeAssetType:      {batteryLevelAlarm, onlineAlarm, temperatureAlarm},
+	kontaktio.TagAssetType:        {batteryLevelAlarm, onlineAlarm},
+	kontaktio.BeaconAssetType:     {batteryLevelAlarm, onlineAlarm, temperatureAlarm, humidityAlarm, airQualityAlarm},
+	kontaktio.PortalBeamAssetType: {batteryLevelAlarm, onlineAlarm, temperatureAlarm, humidityAlarm, airQualityAlarm},
+}
+
+// defaultAlarmThresholds apply unless the configuration defines thresholds for the attribute.
+var defaultAlarmThresholds = []apiserver.AlarmThreshold{
+	{Attribute: batteryLevelAlarm.name, Low: common.Ptr(20.0)},
+	{Attribute: onlineAlarm.name, Low: common.Ptr(1.0)},
+}
+
+// alarmThresholds returns the thresholds for each attribute of the asset type. Thresholds of the
+// configuration for the asset type take precedence over those for all asset types, which in turn
+// take precedence over the defaults.
+func alarmThresholds(config apiserver.Configuration, assetType string) map[string]apiserver.AlarmThreshold {
+	thresholds := make(map[string]apiserver.AlarmThreshold)
+	for _, threshold := range defaultAlarmThresholds {
+		thresholds[threshold.Attribute] = threshold
+	}
+	for _, threshold := range config.AlarmThresholds {
+		if threshold.AssetType == "" {
+			thresholds[threshold.Attribute] = threshold
+		}
+	}
+	for _, threshold := range config.AlarmThresholds {
+		if threshold.AssetType == assetType {
+			thresholds[threshold.Attribute] = threshold
+		}
+	}
+	return thresholds
+}
+
+// ensuredThresholds are the thresholds the alarm rules of each device asset were last ensured
+// with, so that the stored rules are only checked again once the thresholds change.
+var (
+	ensuredThresholdsMu sync.Mutex
+	ensuredThresholds   = make(map[int32]string)
+)
+
+// ensureDeviceAlarmRules creates or updates the alarm rules for a device asset according to the
+// thresholds of the configuration. Attributes without low and high threshold get no rule, rules
+// created before are removed.
+func ensureDeviceAlarmRules(ctx context.Context, config apiserver.Configuration, assetType string, assetId int32) error {
+	thresholds := alarmThresholds(config, assetType)
+	key, err := json.Marshal(thresholds)
+	if err != nil {
+		return fmt.Errorf("shouldn't happen: marshalling thresholds: %v", err)
+	}
+	ensuredThresholdsMu.Lock()
+	ensured := ensuredThresholds[assetId] == string(key)
+	ensuredThresholdsMu.Unlock()
+	if ensured {
+		return nil
+	}
+	for _, attribute := range deviceAlarmAttributes[assetType] {
+		threshold, ok := thresholds[attribute.name]
+		if !ok || (threshold.Low == nil && threshold.High == nil) {
+			if err := removeAlarmRule(ctx, assetId, attribute.name); err != nil {
+				return err
+			}
+			continue
+		}
+		rule := api.NewAlarmRule(assetId, attribute.subtype, attribute.name, api.ALARM_PRIORITY_MEDIUM)
+		rule.Low = *api.NewNullableFloat64(threshold.Low)
+		rule.High = *api.NewNullableFloat64(threshold.High)
+		rule.Message = attribute.message
+		if err := ensureAlarmRule(ctx, config, *rule); err != nil {
+			return err
+		}
+	}
+	ensuredThresholdsMu.Lock()
+	ensuredThresholds[assetId] = string(key)
+	ensuredThresholdsMu.Unlock()
+	return nil
+}
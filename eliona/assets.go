@@ -21,6 +21,8 @@ import (
 	"kontakt-io/apiserver"
 	"kontakt-io/conf"
 	kontaktio "kontakt-io/kontakt-io"
+	"kontakt-io/metrics"
+	"kontakt-io/syncrun"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/asset"
@@ -36,7 +38,7 @@ func isTracker(assetType string) bool {
 	return assetType == kontaktio.TagAssetType || assetType == kontaktio.BadgeAssetType
 }
 
-func createAssetIfNecessary(config apiserver.Configuration, projectId string, id string, parentId *int32, assetType string, name string, roomNumber *int32) (int32, error) {
+func createAssetIfNecessary(ctx context.Context, config apiserver.Configuration, projectId string, id string, parentId *int32, assetType string, name string, roomNumber *int32) (created bool, assetID int32, err error) {
 	assetData := assetData{
 		config:                  config,
 		projectId:               projectId,
@@ -47,29 +49,32 @@ func createAssetIfNecessary(config apiserver.Configuration, projectId string, id
 		description:             fmt.Sprintf("%s (%v)", name, id),
 		roomNumber:              roomNumber,
 	}
-	_, assetID, err := upsertAsset(assetData)
+	created, assetID, err = upsertAsset(ctx, assetData)
 	if err != nil {
-		return 0, fmt.Errorf("creating asset for %s %s and project %v: %v", assetType, name, projectId, err)
+		return false, 0, fmt.Errorf("creating asset for %s %s and project %v: %v", assetType, name, projectId, err)
 	}
-	return assetID, nil
+	return created, assetID, nil
 }
 
-func CreateLocationAssetsIfNecessary(config apiserver.Configuration, rooms []kontaktio.Room) error {
+func CreateLocationAssetsIfNecessary(ctx context.Context, config apiserver.Configuration, rooms []kontaktio.Room) error {
 	for _, projectId := range conf.ProjIds(config) {
-		rootAssetID, err := createRootAssetIfNecessary(config, projectId)
+		rootAssetID, err := createRootAssetIfNecessary(ctx, config, projectId)
 		if err != nil {
 			return err
 		}
 		for _, room := range rooms {
-			buildingAssetID, err := createAssetIfNecessary(config, projectId, fmt.Sprint(room.Floor.Building.ID), &rootAssetID, kontaktio.BuildingAssetType, room.Floor.Building.Name, nil)
+			if !room.InProject(projectId) {
+				continue
+			}
+			_, buildingAssetID, err := createAssetIfNecessary(ctx, config, projectId, fmt.Sprint(room.Floor.Building.ID), &rootAssetID, kontaktio.BuildingAssetType, room.Floor.Building.Name, nil)
 			if err != nil {
 				return err
 			}
-			floorAssetID, err := createAssetIfNecessary(config, projectId, fmt.Sprint(room.Floor.ID), &buildingAssetID, kontaktio.FloorAssetType, room.Floor.Name, nil)
+			_, floorAssetID, err := createAssetIfNecessary(ctx, config, projectId, fmt.Sprint(room.Floor.ID), &buildingAssetID, kontaktio.FloorAssetType, room.Floor.Name, nil)
 			if err != nil {
 				return err
 			}
-			if _, err := createAssetIfNecessary(config, projectId, fmt.Sprint(room.ID), &floorAssetID, kontaktio.RoomAssetType, room.Name, &room.RoomNumber); err != nil {
+			if _, _, err := createAssetIfNecessary(ctx, config, projectId, fmt.Sprint(room.ID), &floorAssetID, kontaktio.RoomAssetType, room.Name, &room.RoomNumber); err != nil {
 				return err
 			}
 		}
@@ -77,33 +82,39 @@ func CreateLocationAssetsIfNecessary(config apiserver.Configuration, rooms []kon
 	return nil
 }
 
-func CreateDeviceAssetsIfNecessary(config apiserver.Configuration, devices []kontaktio.Device) error {
+func CreateDeviceAssetsIfNecessary(ctx context.Context, config apiserver.Configuration, devices []kontaktio.Device) error {
 	for _, projectId := range conf.ProjIds(config) {
-		rootAssetID, err := createRootAssetIfNecessary(config, projectId)
+		rootAssetID, err := createRootAssetIfNecessary(ctx, config, projectId)
 		if err != nil {
 			return err
 		}
 		for _, device := range devices {
+			if !device.InProject(projectId) {
+				continue
+			}
 			parentAssetId := rootAssetID
 			if device.RoomNumberIr != nil && *device.RoomNumberIr != 0 {
-				if roomAssetId, err := conf.GetLocationAssetIdByRoomNumber(context.Background(), config, projectId, *device.RoomNumberIr); err != nil {
+				if roomAssetId, err := conf.GetLocationAssetIdByRoomNumber(ctx, config, projectId, *device.RoomNumberIr); err != nil {
 					log.Debug("conf", "finding room number %v: %v", *device.RoomNumberIr, err)
 					// Ignore this error, we can continue with nil.
 				} else if roomAssetId != nil {
 					parentAssetId = *roomAssetId
 				}
 			}
-			_, err := createAssetIfNecessary(config, projectId, device.ID, &parentAssetId, device.Type, device.Name, nil)
+			_, assetId, err := createAssetIfNecessary(ctx, config, projectId, device.ID, &parentAssetId, device.Type, device.Name, nil)
 			if err != nil {
 				return err
 			}
+			if err := ensureDeviceAlarmRules(ctx, config, device.Type, assetId); err != nil {
+				return fmt.Errorf("ensuring alarm rules for device %s: %v", device.ID, err)
+			}
 		}
 	}
 	return nil
 }
 
-func createRootAssetIfNecessary(config apiserver.Configuration, projectId string) (int32, error) {
-	rootAssetID, err := createAssetIfNecessary(config, projectId, "", nil, kontaktio.RootAssetType, "Kontakt.io", nil)
+func createRootAssetIfNecessary(ctx context.Context, config apiserver.Configuration, projectId string) (int32, error) {
+	_, rootAssetID, err := createAssetIfNecessary(ctx, config, projectId, "", nil, kontaktio.RootAssetType, "Kontakt.io", nil)
 	return rootAssetID, err
 }
 
@@ -119,11 +130,11 @@ type assetData struct {
 	roomNumber              *int32
 }
 
-func upsertAsset(d assetData) (created bool, assetID int32, err error) {
+func upsertAsset(ctx context.Context, d assetData) (created bool, assetID int32, err error) {
 	// Get known asset id from configuration
-	currentAssetID, err := conf.GetTagAssetId(context.Background(), d.config, d.projectId, d.identifier)
+	currentAssetID, err := conf.GetTagAssetId(ctx, d.config, d.projectId, d.identifier)
 	if isLocation(d.assetType) {
-		currentAssetID, err = conf.GetLocationAssetId(context.Background(), d.config, d.projectId, d.identifier)
+		currentAssetID, err = conf.GetLocationAssetId(ctx, d.config, d.projectId, d.identifier)
 	}
 	if err != nil {
 		return false, 0, fmt.Errorf("finding asset ID: %v", err)
@@ -143,6 +154,7 @@ func upsertAsset(d assetData) (created bool, assetID int32, err error) {
 		IsTracker:               *api.NewNullableBool(common.Ptr(isTracker(d.assetType))),
 	}
 	newID, err := asset.UpsertAsset(a)
+	metrics.CountElionaWrite(ctx, metrics.WriteAsset, err)
 	if err != nil {
 		return false, 0, fmt.Errorf("upserting asset %+v into Eliona: %v", a, err)
 	}
@@ -152,16 +164,17 @@ func upsertAsset(d assetData) (created bool, assetID int32, err error) {
 
 	// Remember the asset id for further usage
 	if !isLocation(d.assetType) {
-		if err := conf.InsertDevice(context.Background(), d.config, d.projectId, d.identifier, *newID); err != nil {
+		if err := conf.InsertDevice(ctx, d.config, d.projectId, d.identifier, *newID); err != nil {
 			return false, 0, fmt.Errorf("inserting asset to config db: %v", err)
 		}
 	} else {
-		if err := conf.InsertLocation(context.Background(), d.config, d.projectId, d.identifier, d.roomNumber, *newID); err != nil {
+		if err := conf.InsertLocation(ctx, d.config, d.projectId, d.identifier, d.roomNumber, *newID); err != nil {
 			return false, 0, fmt.Errorf("inserting asset to config db: %v", err)
 		}
 	}
 
 	log.Debug("eliona", "Created new asset for project %s and device %s.", d.projectId, d.identifier)
+	syncrun.AddAssetCreated(ctx)
 
 	return true, *newID, nil
 }
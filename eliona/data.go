@@ -21,6 +21,8 @@ import (
 	"kontakt-io/apiserver"
 	"kontakt-io/conf"
 	kontaktio "kontakt-io/kontakt-io"
+	"kontakt-io/metrics"
+	"kontakt-io/syncrun"
 	"time"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
@@ -29,34 +31,40 @@ import (
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
-func UpsertLocationData(config apiserver.Configuration, rooms []kontaktio.Room) error {
-	floors := make(map[int]kontaktio.Floor)
-	buildings := make(map[int]kontaktio.Building)
+func UpsertLocationData(ctx context.Context, config apiserver.Configuration, rooms []kontaktio.Room) error {
+	for _, projectId := range conf.ProjIds(config) {
+		floors := make(map[int]kontaktio.Floor)
+		buildings := make(map[int]kontaktio.Building)
 
-	for _, room := range rooms {
-		// Ensure floors and buildings are unique.
-		floor := room.Floor
-		floors[floor.ID] = floor
+		for _, room := range rooms {
+			if !room.InProject(projectId) {
+				continue
+			}
+			// Ensure floors and buildings are unique.
+			floor := room.Floor
+			floors[floor.ID] = floor
 
-		building := floor.Building
-		buildings[building.ID] = building
-	}
+			building := floor.Building
+			buildings[building.ID] = building
+		}
 
-	for _, projectId := range conf.ProjIds(config) {
 		for _, room := range rooms {
-			err := upsertRoomData(config, projectId, room)
+			if !room.InProject(projectId) {
+				continue
+			}
+			err := upsertRoomData(ctx, config, projectId, room)
 			if err != nil {
 				return err
 			}
 		}
 		for _, floor := range floors {
-			err := upsertFloorData(config, projectId, floor)
+			err := upsertFloorData(ctx, config, projectId, floor)
 			if err != nil {
 				return err
 			}
 		}
 		for _, building := range buildings {
-			err := upsertBuildingData(config, projectId, building)
+			err := upsertBuildingData(ctx, config, projectId, building)
 			if err != nil {
 				return err
 			}
@@ -67,9 +75,9 @@ func UpsertLocationData(config apiserver.Configuration, rooms []kontaktio.Room)
 
 type roomInfoDataPayload struct{}
 
-func upsertRoomData(config apiserver.Configuration, projectId string, room kontaktio.Room) error {
+func upsertRoomData(ctx context.Context, config apiserver.Configuration, projectId string, room kontaktio.Room) error {
 	log.Debug("Eliona", "upserting data for room: config %d and room '%v'", config.Id, room.ID)
-	assetId, err := conf.GetLocationAssetId(context.Background(), config, projectId, kontaktio.RoomAssetType+fmt.Sprint(room.ID))
+	assetId, err := conf.GetLocationAssetId(ctx, config, projectId, kontaktio.RoomAssetType+fmt.Sprint(room.ID))
 	if err != nil {
 		return err
 	}
@@ -77,6 +85,7 @@ func upsertRoomData(config apiserver.Configuration, projectId string, room konta
 		return fmt.Errorf("unable to find asset ID")
 	}
 	if err := upsertData(
+		ctx,
 		api.SUBTYPE_INFO,
 		*assetId,
 		roomInfoDataPayload{},
@@ -86,11 +95,32 @@ func upsertRoomData(config apiserver.Configuration, projectId string, room konta
 	return nil
 }
 
+type roomDwellTimeDataPayload struct {
+	DwellTimeDaily float64 `json:"dwell_time_daily"`
+	VisitsDaily    int64   `json:"visits_daily"`
+}
+
+// UpsertRoomDwellTimeData writes the dwell time (in minutes) and visits of the current day.
+func UpsertRoomDwellTimeData(ctx context.Context, assetId int32, dwellSeconds int64, visits int64) error {
+	if err := upsertData(
+		ctx,
+		api.SUBTYPE_INPUT,
+		assetId,
+		roomDwellTimeDataPayload{
+			DwellTimeDaily: float64(dwellSeconds) / 60,
+			VisitsDaily:    visits,
+		},
+	); err != nil {
+		return fmt.Errorf("upserting dwell time data: %v", err)
+	}
+	return nil
+}
+
 type floorInfoDataPayload struct{}
 
-func upsertFloorData(config apiserver.Configuration, projectId string, floor kontaktio.Floor) error {
+func upsertFloorData(ctx context.Context, config apiserver.Configuration, projectId string, floor kontaktio.Floor) error {
 	log.Debug("Eliona", "upserting data for floor: config %d and floor '%v'", config.Id, floor.ID)
-	assetId, err := conf.GetLocationAssetId(context.Background(), config, projectId, kontaktio.FloorAssetType+fmt.Sprint(floor.ID))
+	assetId, err := conf.GetLocationAssetId(ctx, config, projectId, kontaktio.FloorAssetType+fmt.Sprint(floor.ID))
 	if err != nil {
 		return err
 	}
@@ -98,6 +128,7 @@ func upsertFloorData(config apiserver.Configuration, projectId string, floor kon
 		return fmt.Errorf("unable to find asset ID")
 	}
 	if err := upsertData(
+		ctx,
 		api.SUBTYPE_INFO,
 		*assetId,
 		floorInfoDataPayload{},
@@ -107,11 +138,31 @@ func upsertFloorData(config apiserver.Configuration, projectId string, floor kon
 	return nil
 }
 
+type floorHeightDataPayload struct {
+	Height float64 `json:"height"`
+}
+
+// UpsertFloorHeightData writes the floor height back to the output attribute, so that the
+// dashboard shows the height set through the app API.
+func UpsertFloorHeightData(ctx context.Context, assetId int32, height float64) error {
+	if err := upsertData(
+		ctx,
+		api.SUBTYPE_OUTPUT,
+		assetId,
+		floorHeightDataPayload{
+			Height: height,
+		},
+	); err != nil {
+		return fmt.Errorf("upserting floor height data: %v", err)
+	}
+	return nil
+}
+
 type buildingInfoDataPayload struct{}
 
-func upsertBuildingData(config apiserver.Configuration, projectId string, building kontaktio.Building) error {
+func upsertBuildingData(ctx context.Context, config apiserver.Configuration, projectId string, building kontaktio.Building) error {
 	log.Debug("Eliona", "upserting data for building: config %d and building '%v'", config.Id, building.ID)
-	assetId, err := conf.GetLocationAssetId(context.Background(), config, projectId, kontaktio.BuildingAssetType+fmt.Sprint(building.ID))
+	assetId, err := conf.GetLocationAssetId(ctx, config, projectId, kontaktio.BuildingAssetType+fmt.Sprint(building.ID))
 	if err != nil {
 		return err
 	}
@@ -119,6 +170,7 @@ func upsertBuildingData(config apiserver.Configuration, projectId string, buildi
 		return fmt.Errorf("unable to find asset ID")
 	}
 	if err := upsertData(
+		ctx,
 		api.SUBTYPE_INFO,
 		*assetId,
 		buildingInfoDataPayload{},
@@ -128,10 +180,13 @@ func upsertBuildingData(config apiserver.Configuration, projectId string, buildi
 	return nil
 }
 
-func UpsertDeviceData(config apiserver.Configuration, tags []kontaktio.Device) error {
+func UpsertDeviceData(ctx context.Context, config apiserver.Configuration, tags []kontaktio.Device) error {
 	for _, projectId := range conf.ProjIds(config) {
 		for _, tag := range tags {
-			if err := upsertTagData(config, projectId, tag); err != nil {
+			if !tag.InProject(projectId) {
+				continue
+			}
+			if err := upsertTagData(ctx, config, projectId, tag); err != nil {
 				return fmt.Errorf("upserting tag data: %v", err)
 			}
 		}
@@ -144,8 +199,15 @@ type deviceInfoDataPayload struct {
 	Model    string `json:"model"`
 }
 
+const onlineAttribute = "online"
+
 type deviceStatusDataPayload struct {
 	BatteryLevel int `json:"battery_level"`
+	Online       int `json:"online"`
+}
+
+type deviceOnlineStatusDataPayload struct {
+	Online int `json:"online"`
 }
 
 type badgeInputDataPayload struct {
@@ -174,9 +236,9 @@ type tagInputDataPayload struct {
 	WorldPosition []float64 `json:"pos_world"`
 }
 
-func upsertTagData(config apiserver.Configuration, projectId string, device kontaktio.Device) error {
+func upsertTagData(ctx context.Context, config apiserver.Configuration, projectId string, device kontaktio.Device) error {
 	log.Debug("Eliona", "upserting data for device %+v", device)
-	assetId, err := conf.GetTagAssetId(context.Background(), config, projectId, device.Type+fmt.Sprint(device.ID))
+	assetId, err := conf.GetTagAssetId(ctx, config, projectId, device.Type+fmt.Sprint(device.ID))
 	if err != nil {
 		return fmt.Errorf("getting asset id: %v", err)
 	}
@@ -184,6 +246,7 @@ func upsertTagData(config apiserver.Configuration, projectId string, device kont
 		return fmt.Errorf("unable to find asset ID")
 	}
 	if err := upsertData(
+		ctx,
 		api.SUBTYPE_INFO,
 		*assetId,
 		deviceInfoDataPayload{
@@ -194,10 +257,12 @@ func upsertTagData(config apiserver.Configuration, projectId string, device kont
 		return err
 	}
 	if err := upsertData(
+		ctx,
 		api.SUBTYPE_STATUS,
 		*assetId,
 		deviceStatusDataPayload{
 			BatteryLevel: device.BatteryLevel,
+			Online:       1,
 		},
 	); err != nil {
 		return err
@@ -234,21 +299,94 @@ func upsertTagData(config apiserver.Configuration, projectId string, device kont
 	default:
 		return fmt.Errorf("unknown asset type \"%s\"", device.Type)
 	}
-	if err := upsertData(api.SUBTYPE_INPUT, *assetId, inputData); err != nil {
+	if err := upsertData(ctx, api.SUBTYPE_INPUT, *assetId, inputData); err != nil {
 		return err
 	}
 	return nil
 }
 
-func upsertData(subtype api.DataSubtype, assetId int32, payload any) error {
+// UpsertOfflineDeviceData marks the assets of all devices not reported by Kontakt.io as offline.
+func UpsertOfflineDeviceData(ctx context.Context, config apiserver.Configuration, devices []kontaktio.Device) error {
+	tags, err := conf.GetTags(ctx, config)
+	if err != nil {
+		return fmt.Errorf("getting device assets: %v", err)
+	}
+	online := make(map[string]bool, len(devices))
+	for _, device := range devices {
+		online[device.Type+device.ID] = true
+	}
+	for _, tag := range tags {
+		if online[tag.GlobalAssetID] || tag.GlobalAssetID == kontaktio.RootAssetType {
+			continue
+		}
+		if err := upsertData(
+			ctx,
+			api.SUBTYPE_STATUS,
+			tag.AssetID.Int32,
+			deviceOnlineStatusDataPayload{
+				Online: 0,
+			},
+		); err != nil {
+			return fmt.Errorf("upserting offline state of %s: %v", tag.GlobalAssetID, err)
+		}
+	}
+	return nil
+}
+
+type batteryForecastStatusDataPayload struct {
+	BatteryDischargeRate float64 `json:"battery_discharge_rate"`
+	BatteryDaysLeft      float64 `json:"battery_days_left"`
+}
+
+// UpsertBatteryForecastData writes the discharge rate (in percentage points per day) and the
+// days left until the battery is depleted.
+func UpsertBatteryForecastData(ctx context.Context, config apiserver.Configuration, device kontaktio.Device, dischargeRate float64, daysLeft float64) error {
+	return forEachDeviceAsset(ctx, config, device, func(assetId int32) error {
+		if err := upsertData(
+			ctx,
+			api.SUBTYPE_STATUS,
+			assetId,
+			batteryForecastStatusDataPayload{
+				BatteryDischargeRate: dischargeRate,
+				BatteryDaysLeft:      daysLeft,
+			},
+		); err != nil {
+			return fmt.Errorf("upserting battery forecast data: %v", err)
+		}
+		return nil
+	})
+}
+
+type firmwareStatusDataPayload struct {
+	FirmwareOutdated int `json:"firmware_outdated"`
+}
+
+// UpsertFirmwareOutdatedData writes whether the firmware of the device is older than the target version.
+func UpsertFirmwareOutdatedData(ctx context.Context, config apiserver.Configuration, device kontaktio.Device, outdated bool) error {
+	payload := firmwareStatusDataPayload{}
+	if outdated {
+		payload.FirmwareOutdated = 1
+	}
+	return forEachDeviceAsset(ctx, config, device, func(assetId int32) error {
+		return upsertData(ctx, api.SUBTYPE_STATUS, assetId, payload)
+	})
+}
+
+func upsertData(ctx context.Context, subtype api.DataSubtype, assetId int32, payload any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	var statusData api.Data
 	statusData.Subtype = subtype
 	now := time.Now()
 	statusData.Timestamp = *api.NewNullableTime(&now)
 	statusData.AssetId = assetId
 	statusData.Data = common.StructToMap(payload)
-	if err := asset.UpsertDataIfAssetExists(statusData); err != nil {
+	err := asset.UpsertDataIfAssetExists(statusData)
+	metrics.CountElionaWrite(ctx, metrics.WriteData, err)
+	if err != nil {
 		return fmt.Errorf("upserting data: %v", err)
 	}
+	syncrun.AddDataPoint(ctx)
 	return nil
 }
@@ -1,21 +1,181 @@
 package eliona
 
 import (
+	"context"
+	"fmt"
+	"kontakt-io/conf"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-utils/common"
 	"github.com/eliona-smart-building-assistant/go-utils/http"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
 	"github.com/gorilla/websocket"
 )
 
-// ListenForOutputChanges generates a websocket connection to the database and listens for any updates
-// on assets (only output attributes). Returns a channel with all changes.
-func ListenForOutputChanges() (chan api.Data, error) {
-	reconnectTime, _ := time.ParseDuration("1s")
-	outputs := make(chan api.Data)
-	go http.ListenWebSocketWithReconnect(newWebsocket, reconnectTime, outputs)
-	return outputs, nil
+const minOutputReconnectDelay = time.Second
+const maxOutputReconnectDelay = time.Minute
+
+// outputHandlingTimeout bounds handling a single output, so that a hanging database or API
+// doesn't block the listener.
+const outputHandlingTimeout = 30 * time.Second
+
+// OutputHandler processes a value written to an output attribute of an asset created by the app.
+type OutputHandler func(ctx context.Context, assetId int32, value any) error
+
+// FloatOutputHandler wraps a handler for numeric attributes, rejecting values of other types.
+func FloatOutputHandler(handler func(ctx context.Context, assetId int32, value float64) error) OutputHandler {
+	return func(ctx context.Context, assetId int32, value any) error {
+		number, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("expected a number, got %T: %v", value, value)
+		}
+		return handler(ctx, assetId, number)
+	}
+}
+
+type outputRoute struct {
+	assetType string
+	attribute string
+}
+
+// OutputDispatcher listens for changes of output attributes and routes them to the handler
+// registered for the asset type and attribute. Outputs of assets not created by the app are ignored.
+type OutputDispatcher struct {
+	handlers  map[outputRoute]OutputHandler
+	connected atomic.Bool
+}
+
+func NewOutputDispatcher() *OutputDispatcher {
+	return &OutputDispatcher{
+		handlers: make(map[outputRoute]OutputHandler),
+	}
+}
+
+// Handle registers the handler for an output attribute of an asset type.
+func (d *OutputDispatcher) Handle(assetType string, attribute string, handler OutputHandler) {
+	d.handlers[outputRoute{assetType: assetType, attribute: attribute}] = handler
+}
+
+// Listen connects to the Eliona output data listener and dispatches the received outputs. Lost
+// connections are reestablished with an exponential backoff until the context is done. Then the
+// connection is closed, the handling of the output being dispatched is canceled and Listen returns
+// once its handler returned.
+func (d *OutputDispatcher) Listen(ctx context.Context) {
+	delay := minOutputReconnectDelay
+	for ctx.Err() == nil {
+		conn, err := newWebsocket()
+		if err != nil {
+			log.Error("eliona", "connecting to output listener, retrying in %v: %v", delay, err)
+			if !sleep(ctx, delay) {
+				return
+			}
+			delay = nextOutputReconnectDelay(delay)
+			continue
+		}
+		delay = minOutputReconnectDelay
+		d.connected.Store(true)
+		d.listen(ctx, conn)
+		d.connected.Store(false)
+		_ = conn.Close()
+		if !sleep(ctx, delay) {
+			return
+		}
+	}
+}
+
+// Connected reports whether the dispatcher is currently connected to the output data listener.
+func (d *OutputDispatcher) Connected() bool {
+	return d.connected.Load()
+}
+
+func (d *OutputDispatcher) listen(ctx context.Context, conn *websocket.Conn) {
+	// Closing the connection unblocks reading when the context is done.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-done:
+		}
+	}()
+	for {
+		output, err := http.ReadWebSocket[api.Data](conn)
+		if ctx.Err() != nil {
+			log.Debug("eliona", "output listener stopped")
+			return
+		}
+		if err != nil {
+			log.Error("eliona", "reading from output listener, reconnecting: %v", err)
+			return
+		}
+		if output == nil {
+			log.Debug("eliona", "output listener closed, reconnecting")
+			return
+		}
+		d.dispatch(ctx, *output)
+	}
+}
+
+// sleep waits for the duration and reports false if the context is done before.
+func sleep(ctx context.Context, duration time.Duration) bool {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func nextOutputReconnectDelay(delay time.Duration) time.Duration {
+	delay *= 2
+	if delay > maxOutputReconnectDelay {
+		return maxOutputReconnectDelay
+	}
+	return delay
+}
+
+func (d *OutputDispatcher) dispatch(ctx context.Context, output api.Data) {
+	if assetType := output.AssetTypeName.Get(); assetType != nil && !d.handlesAssetType(*assetType) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, outputHandlingTimeout)
+	defer cancel()
+	globalAssetId, err := conf.GetAssetGlobalId(ctx, output.AssetId)
+	if err != nil {
+		log.Error("conf", "checking owner of asset %v: %v", output.AssetId, err)
+		return
+	}
+	if globalAssetId == nil {
+		// Not an asset of this app.
+		return
+	}
+	for route, handler := range d.handlers {
+		if !strings.HasPrefix(*globalAssetId, route.assetType) {
+			continue
+		}
+		value, ok := output.Data[route.attribute]
+		if !ok {
+			continue
+		}
+		if err := handler(ctx, output.AssetId, value); err != nil {
+			log.Error("eliona", "handling output %s of asset %v: %v", route.attribute, output.AssetId, err)
+		}
+	}
+}
+
+func (d *OutputDispatcher) handlesAssetType(assetType string) bool {
+	for route := range d.handlers {
+		if route.assetType == assetType {
+			return true
+		}
+	}
+	return false
 }
 
 func newWebsocket() (*websocket.Conn, error) {
@@ -0,0 +1,238 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	kontaktio "kontakt-io/kontakt-io"
+	"strings"
+	"time"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+type deviceSettingOutput struct {
+	attribute  string
+	setting    string
+	numeric    bool
+	assetTypes []string
+}
+
+var deviceSettingOutputs = []deviceSettingOutput{
+	{
+		attribute:  "device_name",
+		setting:    kontaktio.SettingName,
+		assetTypes: []string{kontaktio.BadgeAssetType, kontaktio.TagAssetType, kontaktio.BeaconAssetType, kontaktio.PortalBeamAssetType},
+	},
+	{
+		attribute:  "tx_power",
+		setting:    kontaktio.SettingTxPower,
+		numeric:    true,
+		assetTypes: []string{kontaktio.BadgeAssetType, kontaktio.TagAssetType, kontaktio.BeaconAssetType, kontaktio.PortalBeamAssetType},
+	},
+	{
+		attribute:  "interval",
+		setting:    kontaktio.SettingInterval,
+		numeric:    true,
+		assetTypes: []string{kontaktio.BadgeAssetType, kontaktio.TagAssetType, kontaktio.BeaconAssetType, kontaktio.PortalBeamAssetType},
+	},
+	{
+		attribute:  "ir_room_number",
+		setting:    kontaktio.SettingIrRoomNumber,
+		numeric:    true,
+		assetTypes: []string{kontaktio.BeaconAssetType},
+	},
+}
+
+// HandleDeviceSettingOutputs registers the handlers storing device settings changed in Eliona.
+// The settings are pushed to Kontakt.io by SyncDeviceSettings.
+func HandleDeviceSettingOutputs(dispatcher *OutputDispatcher) {
+	for _, output := range deviceSettingOutputs {
+		for _, assetType := range output.assetTypes {
+			dispatcher.Handle(assetType, output.attribute, deviceSettingHandler(assetType, output))
+		}
+	}
+}
+
+func deviceSettingHandler(assetType string, output deviceSettingOutput) OutputHandler {
+	return func(ctx context.Context, assetId int32, value any) error {
+		var formatted string
+		switch v := value.(type) {
+		case float64:
+			if !output.numeric || v != float64(int64(v)) {
+				return fmt.Errorf("invalid value for %s: %v", output.attribute, v)
+			}
+			formatted = fmt.Sprint(int64(v))
+		case string:
+			if output.numeric || v == "" {
+				return fmt.Errorf("invalid value for %s: %q", output.attribute, v)
+			}
+			formatted = v
+		default:
+			return fmt.Errorf("invalid value for %s of type %T: %v", output.attribute, value, value)
+		}
+
+		tag, err := conf.GetTagByAssetId(ctx, assetId)
+		if err != nil {
+			return err
+		}
+		if tag == nil || !strings.HasPrefix(tag.GlobalAssetID, assetType) {
+			return fmt.Errorf("asset %v is not a %s", assetId, assetType)
+		}
+		deviceId := strings.TrimPrefix(tag.GlobalAssetID, assetType)
+		stored, err := conf.GetDeviceSetting(ctx, tag.ConfigurationID, deviceId, output.setting)
+		if err != nil {
+			return fmt.Errorf("getting setting %s of device %s: %v", output.setting, deviceId, err)
+		}
+		if !deviceSettingChanged(stored, formatted) {
+			return nil
+		}
+		return conf.UpsertDeviceSetting(ctx, &appdb.DeviceSetting{
+			ConfigurationID: tag.ConfigurationID,
+			DeviceID:        deviceId,
+			Attribute:       output.setting,
+			Value:           formatted,
+			State:           conf.DeviceSettingRequested,
+			RequestedAt:     time.Now(),
+		})
+	}
+}
+
+// deviceSettingChanged reports whether the value differs from the last value requested for the
+// setting. Output changes carry all outputs of an asset, so unchanged settings must not be requested
+// again.
+func deviceSettingChanged(stored *appdb.DeviceSetting, value string) bool {
+	return stored == nil || stored.Value != value
+}
+
+// pendingDeviceSettingTimeout is how long a device may take to report a pushed setting before the
+// setting is marked as failed.
+const pendingDeviceSettingTimeout = 7 * 24 * time.Hour
+
+// recentDeviceSettings is how long applied and failed settings are listed in the settings state.
+const recentDeviceSettings = 7 * 24 * time.Hour
+
+// SyncDeviceSettings pushes requested setting changes to Kontakt.io and marks pending changes as
+// applied once the devices report the new values. Settings failing to be pushed for other reasons
+// than a rejection by Kontakt.io are pushed again in the next cycle. The state is written to the
+// device assets.
+func SyncDeviceSettings(ctx context.Context, config apiserver.Configuration, devices []kontaktio.Device) error {
+	settings, err := conf.GetUnappliedDeviceSettings(ctx, *config.Id)
+	if err != nil {
+		return fmt.Errorf("getting unapplied device settings: %v", err)
+	}
+	if len(settings) == 0 {
+		return nil
+	}
+	devicesById := make(map[string]kontaktio.Device, len(devices))
+	for _, device := range devices {
+		devicesById[device.ID] = device
+	}
+
+	changed := make(map[string]bool)
+	for _, setting := range settings {
+		device, reported := devicesById[setting.DeviceID]
+		switch setting.State {
+		case conf.DeviceSettingRequested:
+			if !reported {
+				// Device did not report recently, try again in the next cycle.
+				continue
+			}
+			err := kontaktio.PushDeviceSetting(ctx, config, device, setting.Attribute, setting.Value)
+			switch {
+			case ctx.Err() != nil:
+				return fmt.Errorf("pushing setting %s of device %s: %v", setting.Attribute, setting.DeviceID, ctx.Err())
+			case errors.Is(err, kontaktio.ErrSettingRejected):
+				log.Error("kontakt-io", "pushing setting %s of device %s: %v", setting.Attribute, setting.DeviceID, err)
+				setting.State = conf.DeviceSettingFailed
+				setting.Error.SetValid(err.Error())
+			case err != nil:
+				log.Error("kontakt-io", "pushing setting %s of device %s, retrying in the next cycle: %v", setting.Attribute, setting.DeviceID, err)
+				continue
+			default:
+				setting.State = conf.DeviceSettingPending
+				setting.PushedAt.SetValid(time.Now())
+			}
+		case conf.DeviceSettingPending:
+			switch {
+			case reported && device.SettingApplied(setting.Attribute, setting.Value):
+				setting.State = conf.DeviceSettingApplied
+				setting.AppliedAt.SetValid(time.Now())
+			case setting.PushedAt.Valid && time.Since(setting.PushedAt.Time) > pendingDeviceSettingTimeout:
+				setting.State = conf.DeviceSettingFailed
+				setting.Error.SetValid(fmt.Sprintf("not applied by the device within %v", pendingDeviceSettingTimeout))
+			default:
+				continue
+			}
+		}
+		if err := conf.UpdateDeviceSetting(ctx, setting); err != nil {
+			return fmt.Errorf("updating setting %s of device %s: %v", setting.Attribute, setting.DeviceID, err)
+		}
+		changed[setting.DeviceID] = true
+	}
+
+	for deviceId := range changed {
+		device, ok := devicesById[deviceId]
+		if !ok {
+			// The assets of devices not reporting are unknown, their state is written once they
+			// report and change again.
+			continue
+		}
+		if err := upsertDeviceSettingsState(ctx, config, device); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type deviceSettingsStatusDataPayload struct {
+	SettingsState string `json:"settings_state"`
+}
+
+// upsertDeviceSettingsState writes the state of the unapplied and recent settings of the device.
+func upsertDeviceSettingsState(ctx context.Context, config apiserver.Configuration, device kontaktio.Device) error {
+	settings, err := conf.GetDeviceSettings(ctx, *config.Id, device.ID)
+	if err != nil {
+		return fmt.Errorf("getting settings of device %s: %v", device.ID, err)
+	}
+	var states []string
+	for _, setting := range settings {
+		unapplied := setting.State == conf.DeviceSettingRequested || setting.State == conf.DeviceSettingPending
+		if !unapplied && time.Since(setting.RequestedAt) > recentDeviceSettings {
+			continue
+		}
+		states = append(states, fmt.Sprintf("%s: %s", setting.Attribute, setting.State))
+	}
+	return forEachDeviceAsset(ctx, config, device, func(assetId int32) error {
+		if err := upsertData(
+			ctx,
+			api.SUBTYPE_STATUS,
+			assetId,
+			deviceSettingsStatusDataPayload{
+				SettingsState: strings.Join(states, ", "),
+			},
+		); err != nil {
+			return fmt.Errorf("upserting settings state: %v", err)
+		}
+		return nil
+	})
+}
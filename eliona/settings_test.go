@@ -0,0 +1,45 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	"testing"
+)
+
+func TestDeviceSettingChanged(t *testing.T) {
+	tests := []struct {
+		name   string
+		stored *appdb.DeviceSetting
+		value  string
+		want   bool
+	}{
+		{"never requested", nil, "4", true},
+		{"unchanged requested", &appdb.DeviceSetting{Value: "4", State: conf.DeviceSettingRequested}, "4", false},
+		{"unchanged pending", &appdb.DeviceSetting{Value: "4", State: conf.DeviceSettingPending}, "4", false},
+		{"unchanged applied", &appdb.DeviceSetting{Value: "4", State: conf.DeviceSettingApplied}, "4", false},
+		{"unchanged failed", &appdb.DeviceSetting{Value: "4", State: conf.DeviceSettingFailed}, "4", false},
+		{"changed", &appdb.DeviceSetting{Value: "4", State: conf.DeviceSettingApplied}, "3", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deviceSettingChanged(tt.stored, tt.value); got != tt.want {
+				t.Errorf("deviceSettingChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
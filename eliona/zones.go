@@ -0,0 +1,94 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/conf"
+	kontaktio "kontakt-io/kontakt-io"
+	"strings"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+const zoneAlarmAttribute = "zone_alarm"
+
+type zoneEventDataPayload struct {
+	ZoneEvent string `json:"zone_event"`
+}
+
+type zoneStatusDataPayload struct {
+	Zone      string `json:"zone"`
+	ZoneAlarm int    `json:"zone_alarm"`
+}
+
+// UpsertZoneEvent writes an event like entering or leaving a zone to the device assets.
+func UpsertZoneEvent(ctx context.Context, config apiserver.Configuration, device kontaktio.Device, zoneName string, event string) error {
+	return forEachDeviceAsset(ctx, config, device, func(assetId int32) error {
+		return upsertData(ctx, api.SUBTYPE_STATUS, assetId, zoneEventDataPayload{
+			ZoneEvent: fmt.Sprintf("%s: %s", event, zoneName),
+		})
+	})
+}
+
+// UpsertZoneStatus writes the zones the device is currently in and whether any of them is
+// violated. If withAlarm is set, an alarm rule on the violation is ensured for the device assets.
+func UpsertZoneStatus(ctx context.Context, config apiserver.Configuration, device kontaktio.Device, zoneNames []string, violated bool, withAlarm bool) error {
+	return forEachDeviceAsset(ctx, config, device, func(assetId int32) error {
+		if withAlarm {
+			rule := api.NewAlarmRule(assetId, api.SUBTYPE_STATUS, zoneAlarmAttribute, api.ALARM_PRIORITY_MEDIUM)
+			rule.Equal = *api.NewNullableFloat64(common.Ptr(1.0))
+			rule.Message = map[string]interface{}{
+				"de": "Zonenverletzung durch " + device.Name,
+				"en": "Zone violation by " + device.Name,
+			}
+			if err := ensureAlarmRule(ctx, config, *rule); err != nil {
+				return err
+			}
+		}
+		alarm := 0
+		if violated {
+			alarm = 1
+		}
+		return upsertData(ctx, api.SUBTYPE_STATUS, assetId, zoneStatusDataPayload{
+			Zone:      strings.Join(zoneNames, ", "),
+			ZoneAlarm: alarm,
+		})
+	})
+}
+
+func forEachDeviceAsset(ctx context.Context, config apiserver.Configuration, device kontaktio.Device, f func(assetId int32) error) error {
+	for _, projectId := range conf.ProjIds(config) {
+		if !device.InProject(projectId) {
+			continue
+		}
+		assetId, err := conf.GetTagAssetId(ctx, config, projectId, device.Type+device.ID)
+		if err != nil {
+			return fmt.Errorf("getting asset id: %v", err)
+		}
+		if assetId == nil {
+			// Device was not created as an asset in this project.
+			continue
+		}
+		if err := f(*assetId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
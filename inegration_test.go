@@ -19,12 +19,12 @@ func TestApp(t *testing.T) {
 func assetTypes(t *testing.T) {
 	t.Parallel()
 
-	assert.AssetTypeExists(t, "kontakt_io_badge", []string{"temperature", "pos_world", "battery_level", "model", "firmware"})
+	assert.AssetTypeExists(t, "kontakt_io_badge", []string{"temperature", "pos_world", "battery_level", "model", "firmware", "zone", "zone_event", "zone_alarm", "device_name", "tx_power", "interval", "settings_state", "online", "battery_discharge_rate", "battery_days_left", "firmware_outdated"})
 	assert.AssetTypeExists(t, "kontakt_io_beacon", []string{})
 	assert.AssetTypeExists(t, "kontakt_io_building", []string{})
 	assert.AssetTypeExists(t, "kontakt_io_floor", []string{"height"})
 	assert.AssetTypeExists(t, "kontakt_io_portal_beam", []string{})
-	assert.AssetTypeExists(t, "kontakt_io_room", []string{})
+	assert.AssetTypeExists(t, "kontakt_io_room", []string{"dwell_time_daily", "visits_daily"})
 	assert.AssetTypeExists(t, "kontakt_io_root", []string{})
 	assert.AssetTypeExists(t, "kontakt_io_tag", []string{})
 }
@@ -39,5 +39,5 @@ func widgetTypes(t *testing.T) {
 func schema(t *testing.T) {
 	t.Parallel()
 
-	assert.SchemaExists(t, "kontakt_io", []string{"configuration", "location", "tag"})
+	assert.SchemaExists(t, "kontakt_io", []string{"configuration", "location", "tag", "zone", "zone_presence", "alarm_rule", "room_visit", "position", "floor_plan", "device_setting", "battery_level", "device_inventory", "sync_run"})
 }
@@ -0,0 +1,105 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kontaktio
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/http"
+)
+
+// EndpointTest is the result of requesting a Kontakt.io endpoint with the API key of a
+// configuration.
+type EndpointTest struct {
+	Endpoint   string
+	StatusCode int
+	Latency    time.Duration
+	// Count is the number of entries returned by the endpoint.
+	Count int
+	// PermissionDenied is set if the API key is invalid or lacks the permission for the endpoint.
+	PermissionDenied bool
+	Err              error
+}
+
+// TestConnection requests the rooms, devices, telemetry and positions endpoints with the API key
+// of the configuration. Every endpoint is tested even if others fail. Telemetry is requested for
+// the first device, if any.
+func TestConnection(ctx context.Context, config apiserver.Configuration) []EndpointTest {
+	_, rooms := testEndpoint(ctx, config, "rooms", roomsUrl, apiKeyHeaders(config),
+		func(response locationsResponse) int { return len(response.Content) })
+	devices, devicesTest := testEndpoint(ctx, config, "devices", deviceUrl, deviceHeaders(config),
+		func(response deviceResponse) int { return len(response.Devices) })
+	_, telemetry := testEndpoint(ctx, config, "telemetry", testTelemetryUrl(devices.Devices), apiKeyHeaders(config),
+		func(response telemetryResponse) int { return len(response.Content) })
+	_, positions := testEndpoint(ctx, config, "positions", positionsUrl, apiKeyHeaders(config),
+		func(response positionsResponse) int { return len(response.Content) })
+	return []EndpointTest{rooms, devicesTest, telemetry, positions}
+}
+
+func testEndpoint[T any](ctx context.Context, config apiserver.Configuration, endpoint string, u string, headers map[string]string, count func(T) int) (T, EndpointTest) {
+	test := EndpointTest{Endpoint: endpoint}
+	var response T
+	r, err := http.NewRequestWithHeaders(u, headers)
+	if err != nil {
+		test.Err = fmt.Errorf("creating request to %s: %v", u, err)
+		return response, test
+	}
+	r = r.WithContext(ctx)
+	start := time.Now()
+	// Counted apart from the requests of the collection cycles.
+	response, test.StatusCode, err = read[T](config, "test_"+endpoint, r)
+	test.Latency = time.Since(start)
+	switch {
+	case test.StatusCode == nethttp.StatusUnauthorized:
+		test.PermissionDenied = true
+		test.Err = fmt.Errorf("status %v: the API key is invalid", test.StatusCode)
+	case test.StatusCode == nethttp.StatusForbidden:
+		test.PermissionDenied = true
+		test.Err = fmt.Errorf("status %v: the API key lacks the permission to access %s", test.StatusCode, endpoint)
+	case err != nil:
+		test.Err = fmt.Errorf("reading response from %s: %v", u, err)
+	case test.StatusCode != nethttp.StatusOK:
+		test.Err = fmt.Errorf("status %v while reading response from %s", test.StatusCode, u)
+	default:
+		test.Count = count(response)
+	}
+	return response, test
+}
+
+func apiKeyHeaders(config apiserver.Configuration) map[string]string {
+	return map[string]string{"API-Key": config.ApiKey}
+}
+
+// testTelemetryUrl returns the URL of the recent telemetry of the first device, tracked by its
+// lower case MAC address like in GetDevices. Without devices, the telemetry of all devices is
+// requested, which is empty as well.
+func testTelemetryUrl(devices []deviceInfo) string {
+	now := time.Now().UTC()
+	q := url.Values{}
+	q.Set("startTime", now.Add(-2*time.Minute).Format(time.RFC3339))
+	q.Set("endTime", now.Format(time.RFC3339))
+	q.Set("size", "2000")
+	if len(devices) > 0 {
+		q.Set("trackingId", strings.ToLower(devices[0].Mac))
+	}
+	return telemetryUrl + "?" + q.Encode()
+}
@@ -0,0 +1,47 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kontaktio
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestTestTelemetryUrl(t *testing.T) {
+	tests := []struct {
+		name       string
+		devices    []deviceInfo
+		trackingId string
+	}{
+		{"no devices", nil, ""},
+		{"first device", []deviceInfo{{ID: "abc1", Mac: "AA:BB:CC:DD:EE:FF"}, {ID: "abc2", Mac: "11:22:33:44:55:66"}}, "aa:bb:cc:dd:ee:ff"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(testTelemetryUrl(tt.devices))
+			if err != nil {
+				t.Fatalf("parsing url: %v", err)
+			}
+			q := u.Query()
+			if got := q.Get("trackingId"); got != tt.trackingId {
+				t.Errorf("trackingId = %q, want %q", got, tt.trackingId)
+			}
+			if q.Get("startTime") == "" || q.Get("endTime") == "" {
+				t.Errorf("missing time range in %v", u)
+			}
+		})
+	}
+}
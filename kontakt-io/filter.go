@@ -0,0 +1,499 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kontaktio
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/conf"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Operators of asset filter rules. Rules without an operator match the regular expression.
+const (
+	FilterOperatorRegex          = "regex"
+	FilterOperatorEqual          = "eq"
+	FilterOperatorNotEqual       = "ne"
+	FilterOperatorLess           = "lt"
+	FilterOperatorLessOrEqual    = "le"
+	FilterOperatorGreater        = "gt"
+	FilterOperatorGreaterOrEqual = "ge"
+)
+
+// locationParameters are the filter parameters which need the device locations to be resolved.
+var locationParameters = map[string]bool{
+	"building":    true,
+	"building_id": true,
+	"floor":       true,
+	"floor_id":    true,
+	"room":        true,
+	"room_id":     true,
+}
+
+// maxCachedRegexps bounds the compiled regular expressions of filter rules kept in memory. The
+// cache is cleared when full, e.g. after many filter previews with different expressions.
+const maxCachedRegexps = 1000
+
+var (
+	regexpsMu sync.Mutex
+	regexps   = make(map[string]*regexp.Regexp)
+)
+
+// compileRegexp compiles the regular expression of a filter rule once, as filters are applied to
+// every device and room in each cycle.
+func compileRegexp(expr string) (*regexp.Regexp, error) {
+	regexpsMu.Lock()
+	defer regexpsMu.Unlock()
+	if r, ok := regexps[expr]; ok {
+		return r, nil
+	}
+	r, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(regexps) >= maxCachedRegexps {
+		regexps = make(map[string]*regexp.Regexp)
+	}
+	regexps[expr] = r
+	return r, nil
+}
+
+// deviceLocation is where a device is, either by its last position or by the room of its IR room number.
+type deviceLocation struct {
+	BuildingName *string `eliona:"building,filterable"`
+	BuildingID   *int    `eliona:"building_id,filterable"`
+	FloorName    *string `eliona:"floor,filterable"`
+	FloorID      *int    `eliona:"floor_id,filterable"`
+	RoomName     *string `eliona:"room,filterable"`
+	RoomID       *int    `eliona:"room_id,filterable"`
+}
+
+// fetchLocatedDeviceInfos fetches the devices and resolves their locations and projects. The
+// positions are returned as well, so that they don't need to be fetched again. Positions on floors
+// excluded by the location filter or mapped to no project are left out.
+func fetchLocatedDeviceInfos(ctx context.Context, config apiserver.Configuration) ([]deviceInfo, []Device, error) {
+	infos, positions, err := fetchDeviceInfosWithPositions(ctx, config)
+	if err != nil {
+		return nil, nil, err
+	}
+	rooms, err := GetRooms(ctx, config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting rooms: %v", err)
+	}
+	included, err := FilterRooms(config, rooms)
+	if err != nil {
+		return nil, nil, err
+	}
+	included, err = MapRoomsToProjects(config, included)
+	if err != nil {
+		return nil, nil, err
+	}
+	locateDeviceInfos(infos, rooms, positions)
+	includedLocations := newLocationSet(included)
+	for i := range infos {
+		included, known := includedLocations.contains(infos[i].location)
+		if known {
+			infos[i].locationExcluded = !included
+		} else {
+			// Can't be assigned to a location passing the location filter.
+			infos[i].locationExcluded = len(config.LocationFilter) > 0
+		}
+	}
+	if err := mapDeviceInfosToProjects(config, infos, included); err != nil {
+		return nil, nil, err
+	}
+	if len(config.LocationFilter) == 0 && len(config.ProjectMappings) == 0 {
+		return infos, positions, nil
+	}
+	includedPositions := make([]Device, 0, len(positions))
+	for _, position := range positions {
+		if includedLocations.floors[position.FloorID] {
+			includedPositions = append(includedPositions, position)
+		}
+	}
+	return infos, includedPositions, nil
+}
+
+// fetchDeviceInfosWithPositions fetches the devices and their positions without resolving their
+// locations.
+func fetchDeviceInfosWithPositions(ctx context.Context, config apiserver.Configuration) ([]deviceInfo, []Device, error) {
+	infos, err := fetchDeviceInfos(ctx, config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetching devices: %v", err)
+	}
+	positions, err := fetchPositions(ctx, config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetching positions: %v", err)
+	}
+	return infos, positions, nil
+}
+
+// locationSet holds the rooms and the floors of the rooms a device can be located in.
+type locationSet struct {
+	rooms  map[int]bool
+	floors map[int]bool
+}
+
+func newLocationSet(rooms []Room) locationSet {
+	set := locationSet{
+		rooms:  make(map[int]bool, len(rooms)),
+		floors: make(map[int]bool),
+	}
+	for _, room := range rooms {
+		set.rooms[room.ID] = true
+		set.floors[room.Floor.ID] = true
+	}
+	return set
+}
+
+// contains checks whether the location is in the set. A location without room is in the set if
+// any room of its floor is. The location is unknown if neither room nor floor is known.
+func (set locationSet) contains(location deviceLocation) (included bool, known bool) {
+	switch {
+	case location.RoomID != nil:
+		return set.rooms[*location.RoomID], true
+	case location.FloorID != nil:
+		return set.floors[*location.FloorID], true
+	default:
+		return false, false
+	}
+}
+
+func locateDeviceInfos(infos []deviceInfo, rooms []Room, positions []Device) {
+	roomsById := make(map[int]Room, len(rooms))
+	roomsByNumber := make(map[int32]Room, len(rooms))
+	floorsById := make(map[int]Floor)
+	for _, room := range rooms {
+		roomsById[room.ID] = room
+		if room.RoomNumber != 0 {
+			roomsByNumber[room.RoomNumber] = room
+		}
+		floorsById[room.Floor.ID] = room.Floor
+	}
+	positionsById := make(map[string]Device, len(positions))
+	for _, position := range positions {
+		positionsById[position.ID] = position
+	}
+
+	for i := range infos {
+		info := &infos[i]
+		var room *Room
+		var floor *Floor
+		if position, ok := positionsById[strings.ToLower(info.Mac)]; ok {
+			if r, ok := roomsById[position.RoomID]; ok {
+				room = &r
+			}
+			if f, ok := floorsById[position.FloorID]; ok {
+				floor = &f
+			}
+		}
+		if room == nil && info.RoomNumberIr != nil && *info.RoomNumberIr != 0 {
+			if r, ok := roomsByNumber[*info.RoomNumberIr]; ok {
+				room = &r
+			}
+		}
+		if floor == nil && room != nil {
+			floor = &room.Floor
+		}
+		info.location = deviceLocation{}
+		if room != nil {
+			info.location.RoomName = &room.Name
+			info.location.RoomID = &room.ID
+		}
+		if floor != nil {
+			info.location.FloorName = &floor.Name
+			info.location.FloorID = &floor.ID
+			info.location.BuildingName = &floor.Building.Name
+			info.location.BuildingID = &floor.Building.ID
+		}
+	}
+}
+
+// FilterRooms returns the rooms passing the location filter of the configuration.
+func FilterRooms(config apiserver.Configuration, rooms []Room) ([]Room, error) {
+	filtered := make([]Room, 0, len(rooms))
+	for _, room := range rooms {
+		included, err := matchesFilter(config.LocationFilter, room.filterProperties())
+		if err != nil {
+			return nil, fmt.Errorf("checking if room adheres to the location filter: %v", err)
+		}
+		if included {
+			filtered = append(filtered, room)
+		}
+	}
+	return filtered, nil
+}
+
+func (room Room) filterProperties() map[string]string {
+	return map[string]string{
+		"building":    room.Floor.Building.Name,
+		"building_id": fmt.Sprint(room.Floor.Building.ID),
+		"floor":       room.Floor.Name,
+		"floor_id":    fmt.Sprint(room.Floor.ID),
+		"floor_level": fmt.Sprint(room.Floor.Level),
+		"room":        room.Name,
+		"room_id":     fmt.Sprint(room.ID),
+		"room_number": fmt.Sprint(room.RoomNumber),
+	}
+}
+
+// needsLocations reports whether the filters or project mappings of the configuration depend on
+// the locations of the devices.
+func needsLocations(config apiserver.Configuration) bool {
+	return usesLocationParameters(config.AssetFilter) || len(config.LocationFilter) > 0 || len(config.ProjectMappings) > 0
+}
+
+func usesLocationParameters(filter [][]apiserver.FilterRule) bool {
+	for _, ruleSet := range filter {
+		for _, rule := range ruleSet {
+			if locationParameters[rule.Parameter] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (device *deviceInfo) AdheresToFilter(config apiserver.Configuration) (bool, error) {
+	return adheresToFilter(device, config.AssetFilter)
+}
+
+// AdheresToFilter checks the device properties reported by Kontakt.io against the
+// given filter. The filter uses the same rule syntax as the configuration asset filter.
+func (device Device) AdheresToFilter(filter [][]apiserver.FilterRule) (bool, error) {
+	return adheresToFilter(&device.info, filter)
+}
+
+// adheresToFilter checks whether the device matches the filter.
+func adheresToFilter(device *deviceInfo, filter [][]apiserver.FilterRule) (bool, error) {
+	return matchesFilter(filter, device.filterProperties())
+}
+
+// matchesFilter checks whether the properties match all rules of at least one rule set. An empty
+// filter matches everything.
+func matchesFilter(filter [][]apiserver.FilterRule, properties map[string]string) (bool, error) {
+	if len(filter) == 0 {
+		return true, nil
+	}
+	for _, ruleSet := range filter {
+		matched := true
+		for _, rule := range ruleSet {
+			ruleMatched, err := matchesRule(rule, properties)
+			if err != nil {
+				return false, err
+			}
+			if !ruleMatched {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchesRule applies the rule to the property. A missing property never matches, so negated
+// rules match devices without the property.
+func matchesRule(rule apiserver.FilterRule, properties map[string]string) (bool, error) {
+	property, ok := properties[rule.Parameter]
+	matched := false
+	if ok {
+		var err error
+		matched, err = matchesOperator(rule, property)
+		if err != nil {
+			return false, fmt.Errorf("applying rule on %s: %v", rule.Parameter, err)
+		}
+	}
+	return matched != rule.Negate, nil
+}
+
+func matchesOperator(rule apiserver.FilterRule, property string) (bool, error) {
+	switch rule.Operator {
+	case "", FilterOperatorRegex:
+		r, err := compileRegexp(rule.Regex)
+		if err != nil {
+			return false, fmt.Errorf("compiling rule regexp %v: %v", rule.Regex, err)
+		}
+		return r.MatchString(property), nil
+	case FilterOperatorEqual, FilterOperatorNotEqual:
+		equal := property == rule.Value
+		if number, err := strconv.ParseFloat(property, 64); err == nil {
+			if value, err := strconv.ParseFloat(rule.Value, 64); err == nil {
+				equal = number == value
+			}
+		}
+		return equal == (rule.Operator == FilterOperatorEqual), nil
+	case FilterOperatorLess, FilterOperatorLessOrEqual, FilterOperatorGreater, FilterOperatorGreaterOrEqual:
+		value, err := strconv.ParseFloat(rule.Value, 64)
+		if err != nil {
+			return false, fmt.Errorf("parsing rule value %q as number: %v", rule.Value, err)
+		}
+		number, err := strconv.ParseFloat(property, 64)
+		if err != nil {
+			// Not a number, thus not comparable.
+			return false, nil
+		}
+		switch rule.Operator {
+		case FilterOperatorLess:
+			return number < value, nil
+		case FilterOperatorLessOrEqual:
+			return number <= value, nil
+		case FilterOperatorGreater:
+			return number > value, nil
+		default:
+			return number >= value, nil
+		}
+	default:
+		return false, fmt.Errorf("unknown operator %q", rule.Operator)
+	}
+}
+
+// ValidateFilters checks the asset filter, the location filter and the filters of the project
+// mappings of the configuration.
+func ValidateFilters(config apiserver.Configuration) error {
+	filters := [][][]apiserver.FilterRule{config.AssetFilter, config.LocationFilter}
+	for _, mapping := range config.ProjectMappings {
+		filters = append(filters, mapping.LocationFilter, mapping.AssetFilter)
+	}
+	for _, filter := range filters {
+		if err := ValidateFilter(filter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateFilter checks the operators, regular expressions and values of the filter rules.
+func ValidateFilter(filter [][]apiserver.FilterRule) error {
+	for _, ruleSet := range filter {
+		for _, rule := range ruleSet {
+			if rule.Parameter == "" {
+				return fmt.Errorf("%w: filter rule without parameter", conf.ErrBadRequest)
+			}
+			if _, err := matchesOperator(rule, ""); err != nil {
+				return fmt.Errorf("%w: rule on %s: %v", conf.ErrBadRequest, rule.Parameter, err)
+			}
+		}
+	}
+	return nil
+}
+
+// filterProperties returns the properties of the device tagged as filterable. Lists are joined by
+// commas, properties without a value like an unknown location are left out.
+func (device *deviceInfo) filterProperties() map[string]string {
+	properties := make(map[string]string)
+	addFilterProperties(properties, reflect.ValueOf(*device))
+	if device.Venue != nil {
+		properties["venue"] = device.Venue.Name
+		properties["venue_id"] = device.Venue.ID
+	}
+	return properties
+}
+
+func addFilterProperties(properties map[string]string, value reflect.Value) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.Type == reflect.TypeOf(deviceLocation{}) {
+			addFilterProperties(properties, value.Field(i))
+			continue
+		}
+		name, options, _ := strings.Cut(field.Tag.Get("eliona"), ",")
+		if name == "" || !strings.Contains(options, "filterable") {
+			continue
+		}
+		fieldValue := value.Field(i)
+		switch fieldValue.Kind() {
+		case reflect.Pointer:
+			if fieldValue.IsNil() {
+				continue
+			}
+			properties[name] = fmt.Sprint(fieldValue.Elem())
+		case reflect.Slice:
+			items := make([]string, fieldValue.Len())
+			for j := range items {
+				items[j] = fmt.Sprint(fieldValue.Index(j))
+			}
+			properties[name] = strings.Join(items, ",")
+		default:
+			properties[name] = fmt.Sprint(fieldValue)
+		}
+	}
+}
+
+// FilterPreview explains whether a device passes an asset filter.
+type FilterPreview struct {
+	Device Device
+	// Properties are the device properties the filter rules are applied to.
+	Properties map[string]string
+	Included   bool
+	// MatchedRuleSet is the index of the first rule set matched by all properties, or -1.
+	MatchedRuleSet int
+	// MismatchedRules are the rules of all rule sets not matched by the properties.
+	MismatchedRules []apiserver.FilterRule
+}
+
+// PreviewAssetFilter applies the filter to all devices of the account without creating any assets.
+// Invalid rules are reported as bad request.
+func PreviewAssetFilter(ctx context.Context, config apiserver.Configuration, filter [][]apiserver.FilterRule) ([]FilterPreview, error) {
+	if err := ValidateFilter(filter); err != nil {
+		return nil, err
+	}
+	infos, _, err := fetchLocatedDeviceInfos(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	previews := make([]FilterPreview, 0, len(infos))
+	for i := range infos {
+		info := &infos[i]
+		included, err := adheresToFilter(info, filter)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", conf.ErrBadRequest, err)
+		}
+		properties := info.filterProperties()
+		preview := FilterPreview{
+			Device:         newDevice(*info),
+			Properties:     properties,
+			Included:       included,
+			MatchedRuleSet: -1,
+		}
+		preview.Device.Type = productAssetType(info.Product)
+		for setIndex, ruleSet := range filter {
+			matched := true
+			for _, rule := range ruleSet {
+				ruleMatched, err := matchesRule(rule, properties)
+				if err != nil {
+					return nil, fmt.Errorf("%w: %v", conf.ErrBadRequest, err)
+				}
+				if !ruleMatched {
+					matched = false
+					preview.MismatchedRules = append(preview.MismatchedRules, rule)
+				}
+			}
+			if matched && preview.MatchedRuleSet < 0 {
+				preview.MatchedRuleSet = setIndex
+			}
+		}
+		previews = append(previews, preview)
+	}
+	return previews, nil
+}
@@ -0,0 +1,138 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kontaktio
+
+import (
+	"errors"
+	"kontakt-io/apiserver"
+	"kontakt-io/conf"
+	"reflect"
+	"testing"
+)
+
+func TestMatchesRule(t *testing.T) {
+	properties := map[string]string{
+		"name":          "Badge 12",
+		"battery_level": "40",
+		"tags":          "ward,night",
+	}
+	tests := []struct {
+		name    string
+		rule    apiserver.FilterRule
+		want    bool
+		wantErr bool
+	}{
+		{"regex matches", apiserver.FilterRule{Parameter: "name", Regex: "^Badge"}, true, false},
+		{"regex operator", apiserver.FilterRule{Parameter: "name", Operator: FilterOperatorRegex, Regex: "12$"}, true, false},
+		{"regex mismatches", apiserver.FilterRule{Parameter: "name", Regex: "^Tag"}, false, false},
+		{"negated regex", apiserver.FilterRule{Parameter: "name", Regex: "^Tag", Negate: true}, true, false},
+		{"missing property", apiserver.FilterRule{Parameter: "room", Regex: ".*"}, false, false},
+		{"negated missing property", apiserver.FilterRule{Parameter: "room", Regex: ".*", Negate: true}, true, false},
+		{"equal number", apiserver.FilterRule{Parameter: "battery_level", Operator: FilterOperatorEqual, Value: "40.0"}, true, false},
+		{"equal text", apiserver.FilterRule{Parameter: "tags", Operator: FilterOperatorEqual, Value: "ward,night"}, true, false},
+		{"not equal", apiserver.FilterRule{Parameter: "battery_level", Operator: FilterOperatorNotEqual, Value: "40"}, false, false},
+		{"less", apiserver.FilterRule{Parameter: "battery_level", Operator: FilterOperatorLess, Value: "50"}, true, false},
+		{"less or equal", apiserver.FilterRule{Parameter: "battery_level", Operator: FilterOperatorLessOrEqual, Value: "40"}, true, false},
+		{"greater", apiserver.FilterRule{Parameter: "battery_level", Operator: FilterOperatorGreater, Value: "40"}, false, false},
+		{"greater or equal", apiserver.FilterRule{Parameter: "battery_level", Operator: FilterOperatorGreaterOrEqual, Value: "40"}, true, false},
+		{"comparing text", apiserver.FilterRule{Parameter: "name", Operator: FilterOperatorLess, Value: "50"}, false, false},
+		{"invalid regex", apiserver.FilterRule{Parameter: "name", Regex: "("}, false, true},
+		{"invalid value", apiserver.FilterRule{Parameter: "battery_level", Operator: FilterOperatorLess, Value: "low"}, false, true},
+		{"unknown operator", apiserver.FilterRule{Parameter: "name", Operator: "like"}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchesRule(tt.rule, properties)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("matchesRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("matchesRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  [][]apiserver.FilterRule
+		wantErr bool
+	}{
+		{"empty", nil, false},
+		{"valid rules", [][]apiserver.FilterRule{
+			{{Parameter: "name", Regex: "^Badge"}, {Parameter: "battery_level", Operator: FilterOperatorGreater, Value: "20"}},
+			{{Parameter: "product", Operator: FilterOperatorEqual, Value: "Smart Badge"}},
+		}, false},
+		{"without parameter", [][]apiserver.FilterRule{{{Regex: ".*"}}}, true},
+		{"invalid regex in second set", [][]apiserver.FilterRule{{{Parameter: "name", Regex: ".*"}}, {{Parameter: "name", Regex: "[a-"}}}, true},
+		{"invalid value", [][]apiserver.FilterRule{{{Parameter: "battery_level", Operator: FilterOperatorGreaterOrEqual, Value: ""}}}, true},
+		{"unknown operator", [][]apiserver.FilterRule{{{Parameter: "name", Operator: "contains", Value: "a"}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFilter(tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, conf.ErrBadRequest) {
+				t.Errorf("ValidateFilter() error = %v, want a bad request", err)
+			}
+		})
+	}
+}
+
+func TestFilterProperties(t *testing.T) {
+	roomNumber := int32(7)
+	roomName := "Ward A"
+	roomId := 12
+	tests := []struct {
+		name   string
+		device deviceInfo
+		want   map[string]string
+	}{
+		{
+			name:   "without location",
+			device: deviceInfo{ID: "abc", Mac: "AA:BB", Name: "Badge", Product: "Smart Badge", BatteryLevel: 80, Firmware: "1.2"},
+			want: map[string]string{
+				"id": "abc", "mac": "AA:BB", "name": "Badge", "product": "Smart Badge", "battery_level": "80",
+				"firmware": "1.2", "model": "", "tags": "",
+			},
+		},
+		{
+			name: "with room, tags and venue",
+			device: deviceInfo{
+				ID:           "abc",
+				RoomNumberIr: &roomNumber,
+				Tags:         []string{"ward", "night"},
+				Venue:        &venue{ID: "v1", Name: "Hospital"},
+				location:     deviceLocation{RoomName: &roomName, RoomID: &roomId},
+			},
+			want: map[string]string{
+				"id": "abc", "mac": "", "name": "", "product": "", "battery_level": "0", "firmware": "", "model": "",
+				"ir_room_number": "7", "tags": "ward,night", "venue": "Hospital", "venue_id": "v1",
+				"room": "Ward A", "room_id": "12",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.device.filterProperties(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterProperties() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -17,17 +17,17 @@ package kontaktio
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"kontakt-io/apiserver"
 	"kontakt-io/conf"
+	"kontakt-io/metrics"
 	nethttp "net/http"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/eliona-smart-building-assistant/go-eliona/utils"
-
-	"github.com/eliona-smart-building-assistant/go-utils/common"
 	"github.com/eliona-smart-building-assistant/go-utils/http"
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
@@ -42,6 +42,13 @@ const BuildingAssetType = "kontakt_io_building"
 
 const RootAssetType = "kontakt_io_root"
 
+const (
+	roomsUrl     = "https://apps.cloud.us.kontakt.io/v2/locations/rooms?size=2000"
+	deviceUrl    = "https://api.kontakt.io/device"
+	telemetryUrl = "https://apps.cloud.us.kontakt.io/v3/telemetry"
+	positionsUrl = "https://apps.cloud.us.kontakt.io/v2/positions?size=2000"
+)
+
 const productAnchorBeacon = "Anchor Beacon 2"
 const productAssetTag = "Asset Tag 2"
 const productNanoTag = "Nano Tag"
@@ -69,24 +76,27 @@ type Room struct {
 	RoomNumber int32  `json:"roomNumber"`
 	Name       string `json:"name"`
 	Floor      Floor  `json:"floor"`
+
+	// ProjectIDs is set by MapRoomsToProjects. Nil if all projects receive the room.
+	ProjectIDs []string `json:"-"`
 }
 
 type locationsResponse struct {
 	Content []Room `json:"content"`
 }
 
-func GetRooms(config apiserver.Configuration) ([]Room, error) {
-	u := "https://apps.cloud.us.kontakt.io/v2/locations/rooms?size=2000"
-	r, err := http.NewRequestWithApiKey(u, "API-Key", config.ApiKey)
+func GetRooms(ctx context.Context, config apiserver.Configuration) ([]Room, error) {
+	r, err := http.NewRequestWithApiKey(roomsUrl, "API-Key", config.ApiKey)
 	if err != nil {
-		return nil, fmt.Errorf("creating request to %s: %v", u, err)
+		return nil, fmt.Errorf("creating request to %s: %v", roomsUrl, err)
 	}
-	locationsResponse, statusCode, err := http.ReadWithStatusCode[locationsResponse](r, time.Duration(*config.RequestTimeout)*time.Second, true)
+	r = r.WithContext(ctx)
+	locationsResponse, statusCode, err := read[locationsResponse](config, "rooms", r)
 	if err != nil {
-		return nil, fmt.Errorf("reading response from %s: %v", u, err)
+		return nil, fmt.Errorf("reading response from %s: %v", roomsUrl, err)
 	}
 	if statusCode != nethttp.StatusOK {
-		return nil, fmt.Errorf("status %v while reading response from %s", statusCode, u)
+		return nil, fmt.Errorf("status %v while reading response from %s", statusCode, roomsUrl)
 	}
 	return locationsResponse.Content, nil
 }
@@ -111,24 +121,157 @@ type Device struct {
 	PositionX float64   `json:"x"`
 	PositionY float64   `json:"y"`
 	FloorID   int       `json:"floorId"`
+	RoomID    int       `json:"roomId"`
 	Timestamp time.Time `json:"timestamp"`
+
+	// PositionTimestamp is the time of the position, which differs from the timestamp of the
+	// telemetry merged into the device. Zero without position.
+	PositionTimestamp time.Time `json:"-"`
+
+	// MatchesAssetFilter is set by GetDeviceInventory.
+	MatchesAssetFilter bool `json:"-"`
+
+	// ProjectIDs are the Eliona projects receiving the device. Nil if all projects receive it,
+	// i.e. without project mappings.
+	ProjectIDs []string `json:"-"`
+
+	info deviceInfo
 }
 
 type deviceInfo struct {
-	Model        string `json:"model" eliona:"model,filterable"`
-	ID           string `json:"id" eliona:"id,filterable"`
-	BatteryLevel int    `json:"batteryLevel" eliona:"battery_level,filterable"`
-	Product      string `json:"product" eliona:"product,filterable"`
-	Name         string `json:"name" eliona:"name,filterable"`
-	Mac          string `json:"mac" eliona:"mac,filterable"`
-	Firmware     string `json:"firmware" eliona:"firmware,filterable"`
-	RoomNumberIr *int32 `json:"irRoomNumber"`
+	Model        string   `json:"model" eliona:"model,filterable"`
+	ID           string   `json:"id" eliona:"id,filterable"`
+	BatteryLevel int      `json:"batteryLevel" eliona:"battery_level,filterable"`
+	Product      string   `json:"product" eliona:"product,filterable"`
+	Name         string   `json:"name" eliona:"name,filterable"`
+	Mac          string   `json:"mac" eliona:"mac,filterable"`
+	Firmware     string   `json:"firmware" eliona:"firmware,filterable"`
+	RoomNumberIr *int32   `json:"irRoomNumber" eliona:"ir_room_number,filterable"`
+	Tags         []string `json:"tags" eliona:"tags,filterable"`
+	Venue        *venue   `json:"venue"`
+	UniqueID     string   `json:"uniqueId"`
+	DeviceType   string   `json:"deviceType"`
+	TxPower      int      `json:"txPower"`
+	Interval     int      `json:"interval"`
+
+	location         deviceLocation
+	locationExcluded bool
+	projectIds       []string
+}
+
+type venue struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
 }
 
 type deviceResponse struct {
 	Devices []deviceInfo `json:"devices"`
 }
 
+// FloorPlan is the plan image of a floor and the geometry placing it in the Kontakt.io floor
+// coordinate system.
+type FloorPlan struct {
+	Floor
+	ImageURL    string  `json:"imageUrl"`
+	ImageWidth  int32   `json:"imageWidth"`
+	ImageHeight int32   `json:"imageHeight"`
+	Scale       float64 `json:"scale"` // pixels per meter
+	OriginX     float64 `json:"originX"`
+	OriginY     float64 `json:"originY"`
+	Rotation    float64 `json:"rotation"` // degrees
+}
+
+type floorsResponse struct {
+	Content []FloorPlan `json:"content"`
+}
+
+func GetFloorPlans(ctx context.Context, config apiserver.Configuration) ([]FloorPlan, error) {
+	u := "https://apps.cloud.us.kontakt.io/v2/locations/floors?size=2000"
+	r, err := http.NewRequestWithApiKey(u, "API-Key", config.ApiKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating request to %s: %v", u, err)
+	}
+	r = r.WithContext(ctx)
+	floorsResponse, statusCode, err := read[floorsResponse](config, "floors", r)
+	if err != nil {
+		return nil, fmt.Errorf("reading response from %s: %v", u, err)
+	}
+	if statusCode != nethttp.StatusOK {
+		return nil, fmt.Errorf("status %v while reading response from %s", statusCode, u)
+	}
+	return floorsResponse.Content, nil
+}
+
+// GetFloorPlanImage downloads a floor plan image and returns it along with its content type. The API
+// key is only sent to Kontakt.io hosts, as images may be stored elsewhere.
+func GetFloorPlanImage(ctx context.Context, config apiserver.Configuration, imageUrl string) ([]byte, string, error) {
+	var r *nethttp.Request
+	var err error
+	if isKontaktIoUrl(imageUrl) {
+		r, err = http.NewRequestWithApiKey(imageUrl, "API-Key", config.ApiKey)
+	} else {
+		r, err = http.NewRequest(imageUrl)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("creating request to %s: %v", imageUrl, err)
+	}
+	r = r.WithContext(ctx)
+	image, statusCode, err := downloadFloorPlanImage(config, r)
+	if err != nil {
+		return nil, "", fmt.Errorf("reading response from %s: %v", imageUrl, err)
+	}
+	if statusCode != nethttp.StatusOK {
+		return nil, "", fmt.Errorf("status %v while reading response from %s", statusCode, imageUrl)
+	}
+	return image, nethttp.DetectContentType(image), nil
+}
+
+// downloadFloorPlanImage sends the request without the API key to redirect targets outside of
+// Kontakt.io and records the request's metrics.
+func downloadFloorPlanImage(config apiserver.Configuration, r *nethttp.Request) ([]byte, int, error) {
+	start := time.Now()
+	client := nethttp.Client{
+		Timeout:       time.Duration(*config.RequestTimeout) * time.Second,
+		CheckRedirect: stripForeignApiKey,
+	}
+	body, statusCode, err := func() ([]byte, int, error) {
+		response, err := client.Do(r)
+		if err != nil {
+			return nil, 0, err
+		}
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		return body, response.StatusCode, err
+	}()
+	metrics.ObserveKontaktioRequest(config.Id, "floor_image", statusCode, time.Since(start))
+	return body, statusCode, err
+}
+
+// maxRedirects is the number of redirects followed, as by the default HTTP client.
+const maxRedirects = 10
+
+// stripForeignApiKey removes the API key from redirects leaving Kontakt.io. The client copies all
+// headers of the original request to redirects, including the API key.
+func stripForeignApiKey(r *nethttp.Request, via []*nethttp.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	if !isKontaktIoUrl(r.URL.String()) {
+		r.Header.Del("API-Key")
+	}
+	return nil
+}
+
+// isKontaktIoUrl reports whether the URL is an HTTPS URL of kontakt.io or one of its subdomains.
+func isKontaktIoUrl(u string) bool {
+	parsed, err := url.Parse(u)
+	if err != nil || parsed.Scheme != "https" {
+		return false
+	}
+	host := strings.ToLower(parsed.Hostname())
+	return host == "kontakt.io" || strings.HasSuffix(host, ".kontakt.io")
+}
+
 type telemetryResponse struct {
 	Content []Device `json:"content"`
 }
@@ -137,47 +280,67 @@ type positionsResponse struct {
 	Content []Device `json:"content"`
 }
 
-func fetchDevices(config apiserver.Configuration) (map[string]Device, error) {
-	headers := map[string]string{
-		"API-Key": config.ApiKey,
-		"Accept":  "application/vnd.com.kontakt+json;version=10",
-	}
-	deviceUrl := "https://api.kontakt.io/device"
-	r, err := http.NewRequestWithHeaders(deviceUrl, headers)
+func fetchDeviceInfos(ctx context.Context, config apiserver.Configuration) ([]deviceInfo, error) {
+	r, err := http.NewRequestWithHeaders(deviceUrl, deviceHeaders(config))
 	if err != nil {
 		return nil, fmt.Errorf("creating request to %s: %v", deviceUrl, err)
 	}
-	deviceResponse, statusCode, err := http.ReadWithStatusCode[deviceResponse](r, time.Duration(*config.RequestTimeout)*time.Second, true)
+	r = r.WithContext(ctx)
+	deviceResponse, statusCode, err := read[deviceResponse](config, "devices", r)
 	if err != nil {
 		return nil, fmt.Errorf("reading response from %s: %v", deviceUrl, err)
 	}
 	if statusCode != nethttp.StatusOK {
 		return nil, fmt.Errorf("status %v while reading response from %s", statusCode, deviceUrl)
 	}
+	return deviceResponse.Devices, nil
+}
+
+func deviceHeaders(config apiserver.Configuration) map[string]string {
+	return map[string]string{
+		"API-Key": config.ApiKey,
+		"Accept":  "application/vnd.com.kontakt+json;version=10",
+	}
+}
+
+func filterDeviceInfos(config apiserver.Configuration, infos []deviceInfo) (map[string]Device, error) {
 	tags := make(map[string]Device)
-	for _, device := range deviceResponse.Devices {
+	for _, device := range infos {
 		if adheres, err := device.AdheresToFilter(config); err != nil {
 			return nil, fmt.Errorf("checking if device adheres to a device filter: %v", err)
 		} else if !adheres {
 			log.Debug("kontaktio", "Device %v - %v skipped, does not adhere to asset filter.", device.Name, device.Product)
 			continue
 		}
-		uid := strings.ToLower(device.Mac)
-		tags[uid] = Device{
-			ID:           uid,
-			Name:         fmt.Sprintf("%v %v", device.Product, device.Name),
-			BatteryLevel: device.BatteryLevel,
-			Firmware:     device.Firmware,
-			Product:      device.Product,
-			RoomNumberIr: device.RoomNumberIr,
+		if device.locationExcluded {
+			log.Debug("kontaktio", "Device %v - %v skipped, located in a location excluded by the location filter.", device.Name, device.Product)
+			continue
+		}
+		if device.projectIds != nil && len(device.projectIds) == 0 {
+			log.Debug("kontaktio", "Device %v - %v skipped, not mapped to any project.", device.Name, device.Product)
+			continue
 		}
+		tag := newDevice(device)
+		tags[tag.ID] = tag
 	}
 
 	return tags, nil
 }
 
-func fetchTelemetry(config apiserver.Configuration, potentialTags map[string]Device) ([]Device, error) {
-	telemetryUrl := "https://apps.cloud.us.kontakt.io/v3/telemetry"
+func newDevice(device deviceInfo) Device {
+	return Device{
+		ID:           strings.ToLower(device.Mac),
+		Name:         fmt.Sprintf("%v %v", device.Product, device.Name),
+		BatteryLevel: device.BatteryLevel,
+		Firmware:     device.Firmware,
+		Product:      device.Product,
+		RoomNumberIr: device.RoomNumberIr,
+		ProjectIDs:   device.projectIds,
+		info:         device,
+	}
+}
+
+func fetchTelemetry(ctx context.Context, config apiserver.Configuration, potentialTags map[string]Device) ([]Device, error) {
 	u, err := url.Parse(telemetryUrl)
 	if err != nil {
 		return nil, fmt.Errorf("shouldn't happen: parsing telemetry URL: %v", err)
@@ -207,7 +370,8 @@ func fetchTelemetry(config apiserver.Configuration, potentialTags map[string]Dev
 		if err != nil {
 			return nil, fmt.Errorf("creating request to %s: %v", u.String(), err)
 		}
-		telemetryResponse, statusCode, err := http.ReadWithStatusCode[telemetryResponse](r, time.Duration(*config.RequestTimeout)*time.Second, true)
+		r = r.WithContext(ctx)
+		telemetryResponse, statusCode, err := read[telemetryResponse](config, "telemetry", r)
 		if err != nil {
 			return nil, fmt.Errorf("reading response from %s: %v", u.String(), err)
 		}
@@ -220,13 +384,13 @@ func fetchTelemetry(config apiserver.Configuration, potentialTags map[string]Dev
 	return devices, nil
 }
 
-func fetchPositions(config apiserver.Configuration) ([]Device, error) {
-	positionsUrl := "https://apps.cloud.us.kontakt.io/v2/positions?size=2000"
+func fetchPositions(ctx context.Context, config apiserver.Configuration) ([]Device, error) {
 	r, err := http.NewRequestWithApiKey(positionsUrl, "API-Key", config.ApiKey)
 	if err != nil {
 		return nil, fmt.Errorf("creating request to %s: %v", positionsUrl, err)
 	}
-	positionsResponse, statusCode, err := http.ReadWithStatusCode[positionsResponse](r, time.Duration(*config.RequestTimeout)*time.Second, true)
+	r = r.WithContext(ctx)
+	positionsResponse, statusCode, err := read[positionsResponse](config, "positions", r)
 	if err != nil {
 		return nil, fmt.Errorf("reading response from %s: %v", positionsUrl, err)
 	}
@@ -237,15 +401,32 @@ func fetchPositions(config apiserver.Configuration) ([]Device, error) {
 	return positionsResponse.Content, nil
 }
 
-func GetDevices(config apiserver.Configuration) ([]Device, error) {
-	devices, err := fetchDevices(config)
+// GetDevices returns the devices passing the filters that reported recently, with their telemetry
+// and positions. The inventory of all devices, like returned by GetDeviceInventory, is built from
+// the same device information.
+func GetDevices(ctx context.Context, config apiserver.Configuration) (devices []Device, inventory []Device, err error) {
+	var infos []deviceInfo
+	var positions []Device
+	if needsLocations(config) {
+		infos, positions, err = fetchLocatedDeviceInfos(ctx, config)
+	} else {
+		infos, positions, err = fetchDeviceInfosWithPositions(ctx, config)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("fetching devices: %v", err)
+		return nil, nil, err
+	}
+	inventory, err = newInventory(config, infos)
+	if err != nil {
+		return nil, nil, err
+	}
+	filtered, err := filterDeviceInfos(config, infos)
+	if err != nil {
+		return nil, nil, fmt.Errorf("filtering devices: %v", err)
 	}
 
-	telemetry, err := fetchTelemetry(config, devices)
+	telemetry, err := fetchTelemetry(ctx, config, filtered)
 	if err != nil {
-		return nil, fmt.Errorf("fetching telemetry: %v", err)
+		return nil, nil, fmt.Errorf("fetching telemetry: %v", err)
 	}
 
 	tags := make(map[string]Device, len(telemetry))
@@ -259,15 +440,10 @@ func GetDevices(config apiserver.Configuration) ([]Device, error) {
 		tags[t.ID] = t
 	}
 
-	positions, err := fetchPositions(config)
-	if err != nil {
-		return nil, fmt.Errorf("fetching positions: %v", err)
-	}
-
 	for _, p := range positions {
-		f, err := conf.GetLocationIrrespectibleOfProject(context.Background(), config, FloorAssetType+fmt.Sprint(p.FloorID))
+		f, err := conf.GetLocationIrrespectibleOfProject(ctx, config, FloorAssetType+fmt.Sprint(p.FloorID))
 		if err != nil {
-			return nil, fmt.Errorf("finding floor %v (irrespectible of project): %v", p.FloorID, err)
+			return nil, nil, fmt.Errorf("finding floor %v (irrespectible of project): %v", p.FloorID, err)
 		}
 		if f == nil {
 			log.Error("kontakt-io", "found no corresponding location for tag %v floor %v", p.ID, p.FloorID)
@@ -283,15 +459,21 @@ func GetDevices(config apiserver.Configuration) ([]Device, error) {
 		x := p.PositionX - config.AbsoluteX
 		y := p.PositionY - config.AbsoluteY
 		p.WorldPosition = []float64{x, y, floorHeight}
+		p.PositionTimestamp = p.Timestamp
 		if t, ok := tags[p.ID]; ok {
 			t.WorldPosition = p.WorldPosition
+			t.PositionTimestamp = p.PositionTimestamp
+			t.PositionX = p.PositionX
+			t.PositionY = p.PositionY
+			t.FloorID = p.FloorID
+			t.RoomID = p.RoomID
 			p = t
 		}
 		tags[p.ID] = p
 	}
 	tagsSlice := make([]Device, 0, len(tags))
 	for _, tag := range tags {
-		t, ok := devices[tag.ID]
+		t, ok := filtered[tag.ID]
 		if !ok {
 			// This happens due to matching MAC address with trackingID.
 			// As this should only be the case for portal lights that provide no valuable
@@ -303,20 +485,8 @@ func GetDevices(config apiserver.Configuration) ([]Device, error) {
 			log.Debug("kontakt-io", "A tracking ID %v was not matched with a device.", tag.ID)
 			continue
 		}
-		switch t.Product {
-		case productSmartBadge, productAssetTag:
-			tag.Type = BadgeAssetType
-		case productNanoTag:
-			tag.Type = TagAssetType
-		case productAnchorBeacon, productPuckBeacon:
-			tag.Type = BeaconAssetType
-		case productPortalBeam:
-			tag.Type = PortalBeamAssetType
-		case productPortalLight:
-			// Provides no valuable information.
-			continue
-		default:
-			log.Debug("kontakt-io", "Skipped unsupported product: %s", t.Product)
+		tag.Type = productAssetType(t.Product)
+		if tag.Type == "" {
 			continue
 		}
 
@@ -324,35 +494,144 @@ func GetDevices(config apiserver.Configuration) ([]Device, error) {
 		tag.BatteryLevel = t.BatteryLevel
 		tag.Firmware = t.Firmware
 		tag.RoomNumberIr = t.RoomNumberIr
+		tag.ProjectIDs = t.ProjectIDs
+		tag.info = t.info
 		tagsSlice = append(tagsSlice, tag)
 	}
 
-	return tagsSlice, nil
+	return tagsSlice, inventory, nil
 }
 
-func (device *deviceInfo) AdheresToFilter(config apiserver.Configuration) (bool, error) {
-	f := apiFilterToCommonFilter(config.AssetFilter)
-	fp, err := utils.StructToMap(device)
-	if err != nil {
-		return false, fmt.Errorf("converting struct to map: %v", err)
+// GetDeviceInventory returns all devices of the account, whether they reported recently or not.
+// Only the device information like product and firmware is set. The type is empty for products
+// without assets.
+func GetDeviceInventory(ctx context.Context, config apiserver.Configuration) ([]Device, error) {
+	var infos []deviceInfo
+	var err error
+	if needsLocations(config) {
+		infos, _, err = fetchLocatedDeviceInfos(ctx, config)
+	} else {
+		infos, err = fetchDeviceInfos(ctx, config)
 	}
-	adheres, err := common.Filter(f, fp)
 	if err != nil {
-		return false, err
+		return nil, fmt.Errorf("fetching devices: %v", err)
 	}
-	return adheres, nil
+	return newInventory(config, infos)
 }
 
-func apiFilterToCommonFilter(input [][]apiserver.FilterRule) [][]common.FilterRule {
-	result := make([][]common.FilterRule, len(input))
-	for i := 0; i < len(input); i++ {
-		result[i] = make([]common.FilterRule, len(input[i]))
-		for j := 0; j < len(input[i]); j++ {
-			result[i][j] = common.FilterRule{
-				Parameter: input[i][j].Parameter,
-				Regex:     input[i][j].Regex,
-			}
+func newInventory(config apiserver.Configuration, infos []deviceInfo) ([]Device, error) {
+	inventory := make([]Device, 0, len(infos))
+	for _, info := range infos {
+		device := newDevice(info)
+		device.Type = productAssetType(info.Product)
+		adheres, err := info.AdheresToFilter(config)
+		if err != nil {
+			return nil, fmt.Errorf("checking if device adheres to a device filter: %v", err)
 		}
+		mapped := info.projectIds == nil || len(info.projectIds) > 0
+		device.MatchesAssetFilter = adheres && !info.locationExcluded && mapped
+		inventory = append(inventory, device)
 	}
-	return result
+	return inventory, nil
+}
+
+// productAssetType returns the asset type for a Kontakt.io product, or an empty string if the
+// product is not supported.
+func productAssetType(product string) string {
+	switch product {
+	case productSmartBadge, productAssetTag:
+		return BadgeAssetType
+	case productNanoTag:
+		return TagAssetType
+	case productAnchorBeacon, productPuckBeacon:
+		return BeaconAssetType
+	case productPortalBeam:
+		return PortalBeamAssetType
+	case productPortalLight:
+		// Provides no valuable information.
+		return ""
+	default:
+		log.Debug("kontakt-io", "Skipped unsupported product: %s", product)
+		return ""
+	}
+}
+
+// Device settings which can be changed through the Kontakt.io device configuration API.
+const (
+	SettingName         = "name"
+	SettingTxPower      = "txPower"
+	SettingInterval     = "interval"
+	SettingIrRoomNumber = "irRoomNumber"
+)
+
+// ErrSettingRejected is returned by PushDeviceSetting if Kontakt.io rejects the setting. Other
+// errors are transient and the setting can be pushed again.
+var ErrSettingRejected = errors.New("setting rejected")
+
+// PushDeviceSetting creates a pending configuration for the device. Kontakt.io applies it as soon
+// as a gateway or the mobile app reaches the device.
+func PushDeviceSetting(ctx context.Context, config apiserver.Configuration, device Device, setting string, value string) error {
+	headers := map[string]string{
+		"API-Key": config.ApiKey,
+		"Accept":  "application/vnd.com.kontakt+json;version=10",
+	}
+	form := map[string][]string{
+		"uniqueId":   {device.info.UniqueID},
+		"deviceType": {device.info.DeviceType},
+		setting:      {value},
+	}
+	configUrl := "https://api.kontakt.io/config/create"
+	r, err := http.NewPostFormRequestWithHeaders(configUrl, form, headers)
+	if err != nil {
+		return fmt.Errorf("creating request to %s: %v", configUrl, err)
+	}
+	r = r.WithContext(ctx)
+	body, statusCode, err := do(config, "device_config", r)
+	if err != nil {
+		return fmt.Errorf("reading response from %s: %v", configUrl, err)
+	}
+	if statusCode >= 400 && statusCode < 500 && statusCode != nethttp.StatusRequestTimeout && statusCode != nethttp.StatusTooManyRequests {
+		return fmt.Errorf("%w: status %v while creating config at %s: %s", ErrSettingRejected, statusCode, configUrl, body)
+	}
+	if statusCode != nethttp.StatusOK && statusCode != nethttp.StatusCreated {
+		return fmt.Errorf("status %v while creating config at %s: %s", statusCode, configUrl, body)
+	}
+	return nil
+}
+
+// SettingApplied reports whether the device reports the value for the setting.
+func (device Device) SettingApplied(setting string, value string) bool {
+	switch setting {
+	case SettingName:
+		return device.info.Name == value
+	case SettingTxPower:
+		return fmt.Sprint(device.info.TxPower) == value
+	case SettingInterval:
+		return fmt.Sprint(device.info.Interval) == value
+	case SettingIrRoomNumber:
+		return device.info.RoomNumberIr != nil && fmt.Sprint(*device.info.RoomNumberIr) == value
+	}
+	return false
+}
+
+// IsTracker reports whether the device is carried around and thus has a position.
+func (device Device) IsTracker() bool {
+	return device.Type == TagAssetType || device.Type == BadgeAssetType
+}
+
+// read reads the response of a request to a Kontakt.io endpoint and records the request's metrics.
+func read[T any](config apiserver.Configuration, endpoint string, r *nethttp.Request) (T, int, error) {
+	start := time.Now()
+	response, statusCode, err := http.ReadWithStatusCode[T](r, time.Duration(*config.RequestTimeout)*time.Second, true)
+	metrics.ObserveKontaktioRequest(config.Id, endpoint, statusCode, time.Since(start))
+	return response, statusCode, err
+}
+
+// do sends a request to a Kontakt.io endpoint, returns the response body and records the request's
+// metrics.
+func do(config apiserver.Configuration, endpoint string, r *nethttp.Request) ([]byte, int, error) {
+	start := time.Now()
+	body, statusCode, err := http.DoWithStatusCode(r, time.Duration(*config.RequestTimeout)*time.Second, true)
+	metrics.ObserveKontaktioRequest(config.Id, endpoint, statusCode, time.Since(start))
+	return body, statusCode, err
 }
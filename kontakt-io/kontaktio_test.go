@@ -0,0 +1,99 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kontaktio
+
+import (
+	"kontakt-io/apiserver"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsKontaktIoUrl(t *testing.T) {
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://kontakt.io/plan.png", true},
+		{"https://apps.cloud.us.kontakt.io/v2/floors/1/image", true},
+		{"https://APPS.Kontakt.IO:443/plan.png", true},
+		{"http://apps.cloud.us.kontakt.io/plan.png", false},
+		{"https://kontakt.io.example.com/plan.png", false},
+		{"https://evilkontakt.io/plan.png", false},
+		{"https://s3.amazonaws.com/kontakt.io/plan.png", false},
+		{"https://user@example.com#@kontakt.io/plan.png", false},
+		{"not a url", false},
+	}
+	for _, tt := range tests {
+		if got := isKontaktIoUrl(tt.url); got != tt.want {
+			t.Errorf("isKontaktIoUrl(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestDownloadFloorPlanImageStripsApiKeyOnRedirect(t *testing.T) {
+	var receivedKey string
+	target := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		receivedKey = r.Header.Get("API-Key")
+		_, _ = w.Write([]byte("image"))
+	}))
+	defer target.Close()
+	redirect := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		nethttp.Redirect(w, r, target.URL, nethttp.StatusFound)
+	}))
+	defer redirect.Close()
+
+	r, err := nethttp.NewRequest(nethttp.MethodGet, redirect.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("API-Key", "secret")
+	timeout := int32(5)
+	body, statusCode, err := downloadFloorPlanImage(apiserver.Configuration{RequestTimeout: &timeout}, r)
+	if err != nil || statusCode != nethttp.StatusOK || string(body) != "image" {
+		t.Fatalf("downloadFloorPlanImage() = %q, %v, %v", body, statusCode, err)
+	}
+	if receivedKey != "" {
+		t.Errorf("API key %q sent to redirect target outside of Kontakt.io", receivedKey)
+	}
+}
+
+func TestStripForeignApiKey(t *testing.T) {
+	tests := []struct {
+		url     string
+		keepKey bool
+	}{
+		{"https://apps.cloud.us.kontakt.io/v2/floors/1/image", true},
+		{"http://apps.cloud.us.kontakt.io/v2/floors/1/image", false},
+		{"https://example.com/plan.png", false},
+	}
+	for _, tt := range tests {
+		r, err := nethttp.NewRequest(nethttp.MethodGet, tt.url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Header.Set("API-Key", "secret")
+		if err := stripForeignApiKey(r, []*nethttp.Request{r}); err != nil {
+			t.Fatalf("stripForeignApiKey(%q) error = %v", tt.url, err)
+		}
+		if kept := r.Header.Get("API-Key") != ""; kept != tt.keepKey {
+			t.Errorf("stripForeignApiKey(%q) kept key %v, want %v", tt.url, kept, tt.keepKey)
+		}
+	}
+	if err := stripForeignApiKey(&nethttp.Request{}, make([]*nethttp.Request, maxRedirects)); err == nil {
+		t.Errorf("stripForeignApiKey() followed more than %d redirects", maxRedirects)
+	}
+}
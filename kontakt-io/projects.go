@@ -0,0 +1,122 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kontaktio
+
+import (
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/conf"
+)
+
+// MapRoomsToProjects sets the projects receiving each room according to the project mappings of
+// the configuration and returns the rooms received by at least one project. Without project
+// mappings, all projects receive all rooms and the project IDs are left nil.
+func MapRoomsToProjects(config apiserver.Configuration, rooms []Room) ([]Room, error) {
+	if len(config.ProjectMappings) == 0 {
+		return rooms, nil
+	}
+	mappings := projectMappings(config)
+	mapped := make([]Room, 0, len(rooms))
+	for _, room := range rooms {
+		properties := room.filterProperties()
+		room.ProjectIDs = []string{}
+		for _, projectId := range conf.ProjIds(config) {
+			included, err := matchesFilter(mappings[projectId].LocationFilter, properties)
+			if err != nil {
+				return nil, fmt.Errorf("checking if room adheres to the location filter of project %s: %v", projectId, err)
+			}
+			if included {
+				room.ProjectIDs = append(room.ProjectIDs, projectId)
+			}
+		}
+		if len(room.ProjectIDs) > 0 {
+			mapped = append(mapped, room)
+		}
+	}
+	return mapped, nil
+}
+
+// mapDeviceInfosToProjects sets the projects receiving each device. A project receives a device
+// passing its asset filter if the device is located in one of the project's rooms, or if the
+// location of the device is unknown and the project has no location filter.
+func mapDeviceInfosToProjects(config apiserver.Configuration, infos []deviceInfo, mappedRooms []Room) error {
+	if len(config.ProjectMappings) == 0 {
+		return nil
+	}
+	mappings := projectMappings(config)
+	locations := make(map[string]locationSet)
+	for _, projectId := range conf.ProjIds(config) {
+		var rooms []Room
+		for _, room := range mappedRooms {
+			if inProject(room.ProjectIDs, projectId) {
+				rooms = append(rooms, room)
+			}
+		}
+		locations[projectId] = newLocationSet(rooms)
+	}
+	for i := range infos {
+		info := &infos[i]
+		info.projectIds = []string{}
+		for _, projectId := range conf.ProjIds(config) {
+			mapping := mappings[projectId]
+			adheres, err := adheresToFilter(info, mapping.AssetFilter)
+			if err != nil {
+				return fmt.Errorf("checking if device adheres to the asset filter of project %s: %v", projectId, err)
+			}
+			if !adheres {
+				continue
+			}
+			included, known := locations[projectId].contains(info.location)
+			if !known {
+				included = len(mapping.LocationFilter) == 0
+			}
+			if included {
+				info.projectIds = append(info.projectIds, projectId)
+			}
+		}
+	}
+	return nil
+}
+
+func projectMappings(config apiserver.Configuration) map[string]apiserver.ProjectMapping {
+	mappings := make(map[string]apiserver.ProjectMapping, len(config.ProjectMappings))
+	for _, mapping := range config.ProjectMappings {
+		mappings[mapping.ProjectId] = mapping
+	}
+	return mappings
+}
+
+func inProject(projectIds []string, projectId string) bool {
+	if projectIds == nil {
+		return true
+	}
+	for _, id := range projectIds {
+		if id == projectId {
+			return true
+		}
+	}
+	return false
+}
+
+// InProject checks whether the project receives the room.
+func (room Room) InProject(projectId string) bool {
+	return inProject(room.ProjectIDs, projectId)
+}
+
+// InProject checks whether the project receives the device.
+func (device Device) InProject(projectId string) bool {
+	return inProject(device.ProjectIDs, projectId)
+}
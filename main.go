@@ -16,7 +16,9 @@
 package main
 
 import (
-	"time"
+	"context"
+	"os/signal"
+	"syscall"
 
 	"github.com/eliona-smart-building-assistant/go-eliona/app"
 	"github.com/eliona-smart-building-assistant/go-utils/common"
@@ -42,12 +44,19 @@ func main() {
 	// Initialize the app
 	initialization()
 
+	// Cancels the services on termination, so that they can stop gracefully.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGINT)
+	defer stop()
+
 	// Starting the service to collect the data for this app.
 	common.WaitForWithOs(
-		common.Loop(collectData, time.Second),
-		listenForOutputChanges,
+		func() { superviseConfigs(ctx) },
+		func() { listenForOutputChanges(ctx) },
 		listenApi,
 	)
 
+	stop()
+	shutdown()
+
 	log.Info("main", "Terminate the app.")
 }
@@ -0,0 +1,207 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	"kontakt-io/eliona"
+	kontaktio "kontakt-io/kontakt-io"
+	"sort"
+	"time"
+)
+
+// batterySampleInterval is the minimum time between two recorded battery levels of a device.
+const batterySampleInterval = time.Hour
+
+// batteryHistoryRetention is how long battery levels are kept for the forecast.
+const batteryHistoryRetention = 180 * 24 * time.Hour
+
+// batteryReplacementJump is the increase of the daily battery level, in percentage points, which
+// is taken as a battery replacement. Only the levels after the last replacement are used.
+const batteryReplacementJump = 20
+
+// minBatteryForecastDays is the number of days with battery levels needed for a forecast.
+const minBatteryForecastDays = 3
+
+// maxBatteryForecastDays limits forecasts of devices discharging too slowly to be meaningful.
+const maxBatteryForecastDays = 10 * 365
+
+// BatteryForecast is the estimated discharge of a device battery.
+type BatteryForecast struct {
+	DeviceID     string
+	BatteryLevel float64
+	// DischargeRate is the estimated discharge in percentage points per day.
+	DischargeRate float64
+	DepletionAt   time.Time
+}
+
+// DaysLeft returns the days until the battery is depleted, or 0 if it is overdue.
+func (f BatteryForecast) DaysLeft(now time.Time) float64 {
+	days := f.DepletionAt.Sub(now).Hours() / 24
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
+// RecordBatteryLevels adds the battery levels of the devices to the history, at most once per
+// sample interval, and writes the updated forecast to the assets of the sampled devices.
+func RecordBatteryLevels(ctx context.Context, config apiserver.Configuration, devices []kontaktio.Device) error {
+	latest, err := conf.GetLatestBatteryLevels(ctx, *config.Id)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	var sampled []kontaktio.Device
+	var sampledIDs []string
+	for _, device := range devices {
+		if device.BatteryLevel <= 0 {
+			// Devices without battery report no level.
+			continue
+		}
+		if last, ok := latest[device.ID]; ok && now.Sub(last.RecordedAt) < batterySampleInterval {
+			continue
+		}
+		if err := conf.InsertBatteryLevel(ctx, &appdb.BatteryLevel{
+			ConfigurationID: *config.Id,
+			DeviceID:        device.ID,
+			RecordedAt:      now,
+			BatteryLevel:    int32(device.BatteryLevel),
+		}); err != nil {
+			return fmt.Errorf("inserting battery level of device %s: %v", device.ID, err)
+		}
+		sampled = append(sampled, device)
+		sampledIDs = append(sampledIDs, device.ID)
+	}
+
+	if _, err := conf.DeleteBatteryLevelsBefore(ctx, *config.Id, now.Add(-batteryHistoryRetention)); err != nil {
+		return fmt.Errorf("deleting expired battery levels: %v", err)
+	}
+	if len(sampled) == 0 {
+		return nil
+	}
+
+	forecasts, err := forecastBatteries(ctx, *config.Id, sampledIDs)
+	if err != nil {
+		return err
+	}
+	for _, device := range sampled {
+		forecast, ok := forecasts[device.ID]
+		if !ok {
+			continue
+		}
+		if err := eliona.UpsertBatteryForecastData(ctx, config, device, forecast.DischargeRate, forecast.DaysLeft(now)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBatteryForecasts returns the forecasts of all devices of the configuration with a
+// discharging battery, ordered by depletion date.
+func GetBatteryForecasts(ctx context.Context, configID int64) ([]BatteryForecast, error) {
+	forecasts, err := forecastBatteries(ctx, configID, nil)
+	if err != nil {
+		return nil, err
+	}
+	sorted := make([]BatteryForecast, 0, len(forecasts))
+	for _, forecast := range forecasts {
+		sorted = append(sorted, forecast)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].DepletionAt.Before(sorted[j].DepletionAt)
+	})
+	return sorted, nil
+}
+
+func forecastBatteries(ctx context.Context, configID int64, deviceIDs []string) (map[string]BatteryForecast, error) {
+	levels, err := conf.GetDailyBatteryLevels(ctx, configID, deviceIDs)
+	if err != nil {
+		return nil, err
+	}
+	forecasts := make(map[string]BatteryForecast)
+	for start := 0; start < len(levels); {
+		end := start
+		for end < len(levels) && levels[end].DeviceID == levels[start].DeviceID {
+			end++
+		}
+		if forecast, ok := forecastBattery(levels[start:end]); ok {
+			forecasts[forecast.DeviceID] = forecast
+		}
+		start = end
+	}
+	return forecasts, nil
+}
+
+// forecastBattery fits a line through the daily levels of one device with the Theil-Sen
+// estimator, which is robust against outliers like readings affected by low temperatures.
+func forecastBattery(levels []conf.DailyBatteryLevel) (BatteryForecast, bool) {
+	start := 0
+	for i := 1; i < len(levels); i++ {
+		if levels[i].BatteryLevel-levels[i-1].BatteryLevel >= batteryReplacementJump {
+			start = i
+		}
+	}
+	levels = levels[start:]
+	if len(levels) < minBatteryForecastDays {
+		return BatteryForecast{}, false
+	}
+
+	origin := levels[0].Day
+	days := make([]float64, len(levels))
+	for i, level := range levels {
+		days[i] = level.Day.Sub(origin).Hours() / 24
+	}
+	slopes := make([]float64, 0, len(levels)*(len(levels)-1)/2)
+	for i := range levels {
+		for j := i + 1; j < len(levels); j++ {
+			slopes = append(slopes, (levels[j].BatteryLevel-levels[i].BatteryLevel)/(days[j]-days[i]))
+		}
+	}
+	slope := median(slopes)
+	if slope >= 0 {
+		return BatteryForecast{}, false
+	}
+	intercepts := make([]float64, len(levels))
+	for i, level := range levels {
+		intercepts[i] = level.BatteryLevel - slope*days[i]
+	}
+	depletionDays := -median(intercepts) / slope
+	if depletionDays > maxBatteryForecastDays {
+		return BatteryForecast{}, false
+	}
+
+	last := levels[len(levels)-1]
+	return BatteryForecast{
+		DeviceID:      last.DeviceID,
+		BatteryLevel:  last.BatteryLevel,
+		DischargeRate: -slope,
+		DepletionAt:   origin.Add(time.Duration(depletionDays * 24 * float64(time.Hour))),
+	}, true
+}
+
+func median(values []float64) float64 {
+	sort.Float64s(values)
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+	return (values[n/2-1] + values[n/2]) / 2
+}
@@ -0,0 +1,127 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package maintenance
+
+import (
+	"kontakt-io/conf"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   float64
+	}{
+		{"single", []float64{4}, 4},
+		{"odd", []float64{3, 1, 2}, 2},
+		{"even", []float64{4, 1, 3, 2}, 2.5},
+		{"outlier", []float64{1, 2, 1000}, 2},
+		{"negative", []float64{-1, -3, -2, -4}, -2.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := median(tt.values); got != tt.want {
+				t.Errorf("median(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+// dailyLevels returns the levels of consecutive days starting on the origin.
+func dailyLevels(origin time.Time, levels ...float64) []conf.DailyBatteryLevel {
+	daily := make([]conf.DailyBatteryLevel, len(levels))
+	for i, level := range levels {
+		daily[i] = conf.DailyBatteryLevel{DeviceID: "abc", Day: origin.AddDate(0, 0, i), BatteryLevel: level}
+	}
+	return daily
+}
+
+func TestForecastBattery(t *testing.T) {
+	origin := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		levels        []conf.DailyBatteryLevel
+		wantOk        bool
+		wantRate      float64
+		wantDepletion time.Time
+	}{
+		{
+			name:          "linear discharge",
+			levels:        dailyLevels(origin, 50, 48, 46, 44),
+			wantOk:        true,
+			wantRate:      2,
+			wantDepletion: origin.AddDate(0, 0, 25),
+		},
+		{
+			name:          "outlier ignored",
+			levels:        dailyLevels(origin, 50, 48, 60, 44, 42),
+			wantOk:        true,
+			wantRate:      2,
+			wantDepletion: origin.AddDate(0, 0, 25),
+		},
+		{
+			name:          "battery replaced",
+			levels:        dailyLevels(origin, 12, 8, 4, 90, 89, 88),
+			wantOk:        true,
+			wantRate:      1,
+			wantDepletion: origin.AddDate(0, 0, 93),
+		},
+		{
+			name:   "too few days",
+			levels: dailyLevels(origin, 50, 48),
+		},
+		{
+			name:   "too few days since replacement",
+			levels: dailyLevels(origin, 12, 8, 4, 90, 89),
+		},
+		{
+			name:   "charging",
+			levels: dailyLevels(origin, 40, 41, 42, 43),
+		},
+		{
+			name:   "constant",
+			levels: dailyLevels(origin, 40, 40, 40),
+		},
+		{
+			name:   "discharging too slowly",
+			levels: dailyLevels(origin, 100, 99.99, 99.98, 99.97),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forecast, ok := forecastBattery(tt.levels)
+			if ok != tt.wantOk {
+				t.Fatalf("forecastBattery() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			last := tt.levels[len(tt.levels)-1]
+			if forecast.DeviceID != last.DeviceID || forecast.BatteryLevel != last.BatteryLevel {
+				t.Errorf("forecast of %s at %v, want %s at %v", forecast.DeviceID, forecast.BatteryLevel, last.DeviceID, last.BatteryLevel)
+			}
+			if math.Abs(forecast.DischargeRate-tt.wantRate) > 1e-9 {
+				t.Errorf("discharge rate = %v, want %v", forecast.DischargeRate, tt.wantRate)
+			}
+			if diff := forecast.DepletionAt.Sub(tt.wantDepletion); diff < -time.Second || diff > time.Second {
+				t.Errorf("depletion at %v, want %v", forecast.DepletionAt, tt.wantDepletion)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/eliona"
+	kontaktio "kontakt-io/kontakt-io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GetFirmwareInventory groups the devices of the configuration by product and firmware version and
+// flags the versions older than the target version of the product.
+func GetFirmwareInventory(ctx context.Context, config apiserver.Configuration) ([]apiserver.FirmwareProduct, error) {
+	inventory, err := getFilteredDeviceInventory(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	targets := firmwareTargets(config, inventory)
+
+	deviceIds := make(map[string]map[string][]string)
+	for _, device := range inventory {
+		if deviceIds[device.Product] == nil {
+			deviceIds[device.Product] = make(map[string][]string)
+		}
+		deviceIds[device.Product][device.Firmware] = append(deviceIds[device.Product][device.Firmware], device.ID)
+	}
+
+	products := make([]apiserver.FirmwareProduct, 0, len(deviceIds))
+	for product, versions := range deviceIds {
+		firmwareProduct := apiserver.FirmwareProduct{
+			Product:       product,
+			TargetVersion: targets[product],
+			Versions:      make([]apiserver.FirmwareVersion, 0, len(versions)),
+		}
+		for firmware, ids := range versions {
+			sort.Strings(ids)
+			outdated := isFirmwareOutdated(firmware, targets[product])
+			firmwareProduct.Versions = append(firmwareProduct.Versions, apiserver.FirmwareVersion{
+				Firmware:  firmware,
+				Outdated:  outdated,
+				DeviceIds: ids,
+			})
+			firmwareProduct.DeviceCount += int32(len(ids))
+			if outdated {
+				firmwareProduct.OutdatedCount += int32(len(ids))
+			}
+		}
+		sort.Slice(firmwareProduct.Versions, func(i, j int) bool {
+			return compareFirmware(firmwareProduct.Versions[i].Firmware, firmwareProduct.Versions[j].Firmware) > 0
+		})
+		products = append(products, firmwareProduct)
+	}
+	sort.Slice(products, func(i, j int) bool {
+		return products[i].Product < products[j].Product
+	})
+	return products, nil
+}
+
+// UpsertFirmwareStatus writes to the assets of the inventory devices whether their firmware is outdated.
+func UpsertFirmwareStatus(ctx context.Context, config apiserver.Configuration, inventory []kontaktio.Device) error {
+	inventory = filterDeviceInventory(inventory)
+	targets := firmwareTargets(config, inventory)
+	for _, device := range inventory {
+		outdated := isFirmwareOutdated(device.Firmware, targets[device.Product])
+		if err := eliona.UpsertFirmwareOutdatedData(ctx, config, device, outdated); err != nil {
+			return fmt.Errorf("upserting firmware status of device %s: %v", device.ID, err)
+		}
+	}
+	return nil
+}
+
+func getFilteredDeviceInventory(ctx context.Context, config apiserver.Configuration) ([]kontaktio.Device, error) {
+	inventory, err := kontaktio.GetDeviceInventory(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	return filterDeviceInventory(inventory), nil
+}
+
+// filterDeviceInventory keeps the devices the app creates assets for.
+func filterDeviceInventory(inventory []kontaktio.Device) []kontaktio.Device {
+	filtered := make([]kontaktio.Device, 0, len(inventory))
+	for _, device := range inventory {
+		if device.Type != "" && device.MatchesAssetFilter {
+			filtered = append(filtered, device)
+		}
+	}
+	return filtered
+}
+
+// firmwareTargets returns the target firmware version per product. It is the version configured
+// for the product, or the latest version known from the devices of the product.
+func firmwareTargets(config apiserver.Configuration, inventory []kontaktio.Device) map[string]string {
+	targets := make(map[string]string)
+	for _, device := range inventory {
+		if compareFirmware(device.Firmware, targets[device.Product]) > 0 {
+			targets[device.Product] = device.Firmware
+		}
+	}
+	for product, version := range config.FirmwareVersions {
+		if version != "" {
+			targets[product] = version
+		}
+	}
+	return targets
+}
+
+// isFirmwareOutdated reports whether the firmware is older than the target. Devices without a
+// known firmware are not flagged.
+func isFirmwareOutdated(firmware string, target string) bool {
+	if firmware == "" || target == "" {
+		return false
+	}
+	return compareFirmware(firmware, target) < 0
+}
+
+// compareFirmware compares two firmware versions like "1.12" or "v4.3-beta" part by part, numeric
+// parts by value. Non-numeric parts mark prereleases, so "4.3-beta" is older than "4.3" and
+// "4.3.1". It returns a negative number if a is older than b, a positive one if it is newer.
+func compareFirmware(a string, b string) int {
+	aParts := firmwareParts(a)
+	bParts := firmwareParts(b)
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		// Missing numeric parts count as zero, so "1.2" equals "1.2.0".
+		aPart, bPart := "0", "0"
+		if i < len(aParts) {
+			aPart = aParts[i]
+		}
+		if i < len(bParts) {
+			bPart = bParts[i]
+		}
+		aNumber, aErr := strconv.Atoi(aPart)
+		bNumber, bErr := strconv.Atoi(bPart)
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNumber != bNumber {
+				return aNumber - bNumber
+			}
+		case aErr == nil:
+			// A release or a later version is newer than a prerelease.
+			return 1
+		case bErr == nil:
+			return -1
+		case aPart != bPart:
+			return strings.Compare(aPart, bPart)
+		}
+	}
+	return 0
+}
+
+func firmwareParts(version string) []string {
+	version = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(version)), "v")
+	if version == "" {
+		return nil
+	}
+	return strings.FieldsFunc(version, func(r rune) bool {
+		return r == '.' || r == '-' || r == '_'
+	})
+}
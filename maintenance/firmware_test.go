@@ -0,0 +1,82 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package maintenance
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFirmwareParts(t *testing.T) {
+	tests := []struct {
+		version string
+		want    []string
+	}{
+		{"", nil},
+		{"  ", nil},
+		{"1.12", []string{"1", "12"}},
+		{"v4.3-beta", []string{"4", "3", "beta"}},
+		{" V2_0_1 ", []string{"2", "0", "1"}},
+		{"1..2", []string{"1", "2"}},
+	}
+	for _, tt := range tests {
+		if got := firmwareParts(tt.version); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("firmwareParts(%q) = %q, want %q", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestCompareFirmware(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2", "1.2", 0},
+		{"1.2", "1.2.0", 0},
+		{"v1.2", "1.2", 0},
+		{"1.2", "1.10", -1},
+		{"1.10", "1.9", 1},
+		{"2.0", "1.99.99", 1},
+		{"4.3-beta", "4.3-rc", -1},
+		{"4.3-beta", "4.3-beta", 0},
+		{"4.3", "4.3-beta", 1},
+		{"4.3.1", "4.3-beta", 1},
+		{"4.3.0", "4.3-beta", 1},
+		{"4.2", "4.3-beta", -1},
+		{"", "0", 0},
+		{"", "1.0", -1},
+	}
+	for _, tt := range tests {
+		got := compareFirmware(tt.a, tt.b)
+		if sign(got) != tt.want {
+			t.Errorf("compareFirmware(%q, %q) = %v, want sign %v", tt.a, tt.b, got, tt.want)
+		}
+		if reverse := compareFirmware(tt.b, tt.a); sign(reverse) != -tt.want {
+			t.Errorf("compareFirmware(%q, %q) = %v, want sign %v", tt.b, tt.a, reverse, -tt.want)
+		}
+	}
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,73 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	kontaktio "kontakt-io/kontakt-io"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+// UpdateDeviceInventory stores all devices of the account. Devices reported in this cycle are
+// marked as seen, devices no longer known to Kontakt.io are removed.
+func UpdateDeviceInventory(ctx context.Context, config apiserver.Configuration, inventory []kontaktio.Device, reported []kontaktio.Device) error {
+	stored, err := conf.GetDeviceInventory(ctx, *config.Id)
+	if err != nil {
+		return fmt.Errorf("getting device inventory: %v", err)
+	}
+	lastSeen := make(map[string]null.Time, len(stored))
+	for _, device := range stored {
+		lastSeen[device.DeviceID] = device.LastSeenAt
+	}
+	now := time.Now()
+	for _, device := range reported {
+		seenAt := device.Timestamp
+		if seenAt.IsZero() {
+			seenAt = now
+		}
+		lastSeen[device.ID] = null.TimeFrom(seenAt)
+	}
+
+	deviceIds := make([]string, 0, len(inventory))
+	for _, device := range inventory {
+		inventoryDevice := &appdb.DeviceInventory{
+			ConfigurationID: *config.Id,
+			DeviceID:        device.ID,
+			Name:            device.Name,
+			Product:         device.Product,
+			Firmware:        null.NewString(device.Firmware, device.Firmware != ""),
+			MatchesFilter:   device.MatchesAssetFilter,
+			LastSeenAt:      lastSeen[device.ID],
+		}
+		if device.Type != "" {
+			inventoryDevice.AssetType = null.StringFrom(device.Type)
+		}
+		if err := conf.UpsertDeviceInventory(ctx, inventoryDevice); err != nil {
+			return fmt.Errorf("upserting inventory of device %s: %v", device.ID, err)
+		}
+		deviceIds = append(deviceIds, device.ID)
+	}
+	if _, err := conf.DeleteDeviceInventoryExcept(ctx, *config.Id, deviceIds); err != nil {
+		return fmt.Errorf("deleting removed devices from inventory: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "kontakt_io"
+
+var (
+	kontaktioRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "api_requests_total",
+		Help:      "Requests to the Kontakt.io API by endpoint and response status. The status is \"error\" if no response was received. Endpoints requested by connection tests are prefixed by \"test_\".",
+	}, []string{"config_id", "endpoint", "status"})
+
+	kontaktioRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "api_request_duration_seconds",
+		Help:      "Latency of the requests to the Kontakt.io API by endpoint.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"config_id", "endpoint"})
+
+	devices = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "devices",
+		Help:      "Kontakt.io devices by product in the last collection cycle.",
+	}, []string{"config_id", "product"})
+
+	positionsProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "positions_processed_total",
+		Help:      "Device positions recorded from the Kontakt.io API.",
+	}, []string{"config_id"})
+
+	elionaWrites = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "eliona_writes_total",
+		Help:      "Writes of assets and data to Eliona.",
+	}, []string{"config_id", "kind"})
+
+	elionaWriteFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "eliona_write_failures_total",
+		Help:      "Failed writes of assets and data to Eliona.",
+	}, []string{"config_id", "kind"})
+
+	cycleDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "cycle_duration_seconds",
+		Help:      "Duration of the collection cycles.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+	}, []string{"config_id"})
+
+	cycleLag = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "cycle_lag_seconds",
+		Help:      "Delay of the start of the collection cycles after their scheduled start. Triggered cycles have no delay.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+	}, []string{"config_id"})
+)
+
+// Kinds of writes to Eliona.
+const (
+	WriteAsset = "asset"
+	WriteData  = "data"
+)
+
+// configLabel returns the label value of a configuration ID. Draft configurations without an ID
+// have an empty label.
+func configLabel(configId *int64) string {
+	if configId == nil {
+		return ""
+	}
+	return strconv.FormatInt(*configId, 10)
+}
+
+type configKey struct{}
+
+// WithConfig returns a context whose metrics are labelled by the configuration ID.
+func WithConfig(ctx context.Context, configId int64) context.Context {
+	return context.WithValue(ctx, configKey{}, configId)
+}
+
+func configFromContext(ctx context.Context) *int64 {
+	configId, ok := ctx.Value(configKey{}).(int64)
+	if !ok {
+		return nil
+	}
+	return &configId
+}
+
+// ObserveKontaktioRequest records the latency and the response status of a request to a Kontakt.io
+// endpoint. A status code of 0 means no response was received.
+func ObserveKontaktioRequest(configId *int64, endpoint string, statusCode int, duration time.Duration) {
+	config := configLabel(configId)
+	status := "error"
+	if statusCode != 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	kontaktioRequests.WithLabelValues(config, endpoint, status).Inc()
+	kontaktioRequestDuration.WithLabelValues(config, endpoint).Observe(duration.Seconds())
+}
+
+// SetDevices sets the number of devices per product of the configuration, replacing the products
+// of the previous cycle.
+func SetDevices(configId int64, countsByProduct map[string]int) {
+	config := configLabel(&configId)
+	devices.DeletePartialMatch(prometheus.Labels{"config_id": config})
+	for product, count := range countsByProduct {
+		devices.WithLabelValues(config, product).Set(float64(count))
+	}
+}
+
+// AddPositionsProcessed counts recorded device positions of the configuration.
+func AddPositionsProcessed(configId int64, count int) {
+	positionsProcessed.WithLabelValues(configLabel(&configId)).Add(float64(count))
+}
+
+// CountElionaWrite counts a write of the given kind to Eliona and whether it failed. The
+// configuration is taken from the context, see WithConfig.
+func CountElionaWrite(ctx context.Context, kind string, err error) {
+	config := configLabel(configFromContext(ctx))
+	elionaWrites.WithLabelValues(config, kind).Inc()
+	if err != nil {
+		elionaWriteFailures.WithLabelValues(config, kind).Inc()
+	}
+}
+
+// ObserveCycle records the duration of a collection cycle and its delay after the scheduled start.
+// Cycles are scheduled a refresh interval after the start of the previous one, triggered cycles at
+// their start. The delay is not recorded without scheduled start, e.g. for the first cycle of a
+// worker.
+func ObserveCycle(configId int64, scheduledStart time.Time, start time.Time, end time.Time) {
+	config := configLabel(&configId)
+	cycleDuration.WithLabelValues(config).Observe(end.Sub(start).Seconds())
+	if scheduledStart.IsZero() {
+		return
+	}
+	lag := start.Sub(scheduledStart)
+	if lag < 0 {
+		lag = 0
+	}
+	cycleLag.WithLabelValues(config).Observe(lag.Seconds())
+}
@@ -0,0 +1,164 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package syncrun
+
+import (
+	"context"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	"sync/atomic"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/volatiletech/null/v8"
+)
+
+// retention is how long sync runs are kept.
+const retention = 7 * 24 * time.Hour
+
+// Scopes of a run. Scheduled runs collect locations and devices.
+const (
+	// ScopeAll collects locations and devices.
+	ScopeAll = "all"
+	// ScopeLocations collects locations only.
+	ScopeLocations = "locations"
+	// ScopeDevices collects devices only.
+	ScopeDevices = "devices"
+	// ScopeReconcile collects locations and devices and additionally creates the assets of all
+	// devices passing the filters, even if they haven't reported recently.
+	ScopeReconcile = "reconcile"
+)
+
+// ValidScope reports whether the scope is one of the scopes above.
+func ValidScope(scope string) bool {
+	switch scope {
+	case ScopeAll, ScopeLocations, ScopeDevices, ScopeReconcile:
+		return true
+	}
+	return false
+}
+
+// Queue records a requested run, which is started by the next cycle of the configuration's worker.
+func Queue(ctx context.Context, configID int64, scope string) (*appdb.SyncRun, error) {
+	record := &appdb.SyncRun{
+		ConfigurationID: configID,
+		Scope:           scope,
+		RequestedAt:     null.TimeFrom(time.Now()),
+	}
+	if err := conf.InsertSyncRun(ctx, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Run is a collection run of a configuration. Its counts are increased through the context
+// returned by Start.
+type Run struct {
+	record *appdb.SyncRun
+
+	devicesFetched atomic.Int64
+	assetsCreated  atomic.Int64
+	dataPoints     atomic.Int64
+}
+
+type runKey struct{}
+
+// Start records the start of a collection run. The oldest queued run of the configuration is
+// started if there is one, otherwise a new run with ScopeAll. Failing to record the run doesn't
+// stop the collection, it is logged only.
+func Start(ctx context.Context, configID int64) (context.Context, *Run) {
+	record, err := conf.GetQueuedSyncRun(ctx, configID)
+	if err != nil {
+		log.Error("conf", "finding queued sync run for config %d: %v", configID, err)
+	}
+	if record != nil {
+		record.StartedAt = null.TimeFrom(time.Now())
+		if err := conf.UpdateSyncRun(ctx, record); err != nil {
+			log.Error("conf", "recording start of sync run %d: %v", record.ID, err)
+		}
+	} else {
+		record = &appdb.SyncRun{
+			ConfigurationID: configID,
+			StartedAt:       null.TimeFrom(time.Now()),
+			Scope:           ScopeAll,
+		}
+		if err := conf.InsertSyncRun(ctx, record); err != nil {
+			log.Error("conf", "recording start of sync run for config %d: %v", configID, err)
+		}
+	}
+	run := &Run{record: record}
+	return context.WithValue(ctx, runKey{}, run), run
+}
+
+// Scope returns the scope of the run.
+func (run *Run) Scope() string {
+	return run.record.Scope
+}
+
+// Finish records the end of the run with its counts and the errors of the location and device
+// phases, and removes runs older than the retention.
+func (run *Run) Finish(ctx context.Context, locationErr error, deviceErr error) {
+	record := run.record
+	record.FinishedAt = null.TimeFrom(time.Now())
+	record.DevicesFetched = int32(run.devicesFetched.Load())
+	record.AssetsCreated = int32(run.assetsCreated.Load())
+	record.DataPoints = int32(run.dataPoints.Load())
+	if locationErr != nil {
+		record.LocationError = null.StringFrom(locationErr.Error())
+	}
+	if deviceErr != nil {
+		record.DeviceError = null.StringFrom(deviceErr.Error())
+	}
+	if record.ID == 0 {
+		// Recording the start failed.
+		if err := conf.InsertSyncRun(ctx, record); err != nil {
+			log.Error("conf", "recording sync run for config %d: %v", record.ConfigurationID, err)
+			return
+		}
+	} else if err := conf.UpdateSyncRun(ctx, record); err != nil {
+		log.Error("conf", "recording end of sync run %d: %v", record.ID, err)
+		return
+	}
+	if _, err := conf.DeleteSyncRunsBefore(ctx, record.ConfigurationID, time.Now().Add(-retention)); err != nil {
+		log.Error("conf", "deleting old sync runs for config %d: %v", record.ConfigurationID, err)
+	}
+}
+
+func fromContext(ctx context.Context) *Run {
+	run, _ := ctx.Value(runKey{}).(*Run)
+	return run
+}
+
+// AddDevicesFetched counts devices fetched from Kontakt.io in the run of the context, if any.
+func AddDevicesFetched(ctx context.Context, count int) {
+	if run := fromContext(ctx); run != nil {
+		run.devicesFetched.Add(int64(count))
+	}
+}
+
+// AddAssetCreated counts an asset created in Eliona in the run of the context, if any.
+func AddAssetCreated(ctx context.Context) {
+	if run := fromContext(ctx); run != nil {
+		run.assetsCreated.Add(1)
+	}
+}
+
+// AddDataPoint counts data written to Eliona in the run of the context, if any.
+func AddDataPoint(ctx context.Context) {
+	if run := fromContext(ctx); run != nil {
+		run.dataPoints.Add(1)
+	}
+}
@@ -0,0 +1,84 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	kontaktio "kontakt-io/kontakt-io"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/volatiletech/null/v8"
+)
+
+// ImportFloorPlans stores the floor plans of all floors known as locations. Images are only
+// downloaded again if their URL changed.
+func ImportFloorPlans(ctx context.Context, config apiserver.Configuration) error {
+	floorPlans, err := kontaktio.GetFloorPlans(ctx, config)
+	if err != nil {
+		return fmt.Errorf("getting floor plans: %v", err)
+	}
+	for _, floorPlan := range floorPlans {
+		location, err := conf.GetLocationIrrespectibleOfProject(ctx, config, kontaktio.FloorAssetType+fmt.Sprint(floorPlan.ID))
+		if err != nil {
+			return fmt.Errorf("getting location of floor %v: %v", floorPlan.ID, err)
+		}
+		if location == nil {
+			// Floors without rooms are not created as assets.
+			continue
+		}
+		dbFloorPlan, err := conf.GetFloorPlanByLocation(ctx, location.ID)
+		if err != nil {
+			return fmt.Errorf("getting floor plan of floor %v: %v", floorPlan.ID, err)
+		}
+		if dbFloorPlan == nil {
+			dbFloorPlan = &appdb.FloorPlan{
+				LocationID:      location.ID,
+				ConfigurationID: *config.Id,
+			}
+		}
+		dbFloorPlan.FloorID = int32(floorPlan.ID)
+		dbFloorPlan.ImageWidth = floorPlan.ImageWidth
+		dbFloorPlan.ImageHeight = floorPlan.ImageHeight
+		dbFloorPlan.Scale = floorPlan.Scale
+		dbFloorPlan.OriginX = floorPlan.OriginX
+		dbFloorPlan.OriginY = floorPlan.OriginY
+		dbFloorPlan.Rotation = floorPlan.Rotation
+		if floorPlan.ImageURL != dbFloorPlan.ImageURL.String {
+			dbFloorPlan.ImageURL = null.NewString(floorPlan.ImageURL, floorPlan.ImageURL != "")
+			dbFloorPlan.Image = null.Bytes{}
+			dbFloorPlan.ContentType = null.String{}
+			if floorPlan.ImageURL != "" {
+				image, contentType, err := kontaktio.GetFloorPlanImage(ctx, config, floorPlan.ImageURL)
+				if err != nil {
+					// Keep the geometry, the image is downloaded again in the next cycle.
+					log.Warn("kontakt-io", "getting plan image of floor %v: %v", floorPlan.ID, err)
+					dbFloorPlan.ImageURL = null.String{}
+				} else {
+					dbFloorPlan.Image = null.BytesFrom(image)
+					dbFloorPlan.ContentType = null.StringFrom(contentType)
+				}
+			}
+		}
+		if err := conf.UpsertFloorPlan(ctx, dbFloorPlan); err != nil {
+			return fmt.Errorf("upserting floor plan of floor %v: %v", floorPlan.ID, err)
+		}
+	}
+	return nil
+}
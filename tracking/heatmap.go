@@ -0,0 +1,231 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package tracking
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	"math"
+)
+
+// maxHeatmapCells limits the grid size to keep responses reasonable for small cell sizes.
+const maxHeatmapCells = 250000
+
+// minHeatmapCellSize is the smallest cell edge length in meters, far below the accuracy of the
+// positions.
+const minHeatmapCellSize = 0.01
+
+// heatmapCellPixels is the edge length of a cell when rendering a heatmap without a floor plan.
+// Large grids are rendered with smaller cells to stay within maxHeatmapPixels.
+const heatmapCellPixels = 16
+
+// maxHeatmapPixels limits the size of a rendered heatmap. Larger floor plans are scaled down.
+const maxHeatmapPixels = 2048 * 2048
+
+// maxFloorPlanPixels limits the size of floor plan images decoded to render a heatmap.
+const maxFloorPlanPixels = 8192 * 8192
+
+// ComputeHeatmap counts the positions in a grid of square cells. The grid is aligned to multiples
+// of the cell size and covers all positions.
+func ComputeHeatmap(floorID int32, positions []*appdb.Position, cellSize float64) (apiserver.Heatmap, error) {
+	if math.IsNaN(cellSize) || math.IsInf(cellSize, 0) || cellSize < minHeatmapCellSize {
+		return apiserver.Heatmap{}, fmt.Errorf("%w: the cell size must be at least %v", conf.ErrBadRequest, minHeatmapCellSize)
+	}
+	heatmap := apiserver.Heatmap{
+		FloorId:  floorID,
+		CellSize: cellSize,
+		Cells:    [][]int64{},
+	}
+	if len(positions) == 0 {
+		return heatmap, nil
+	}
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, position := range positions {
+		minX, maxX = math.Min(minX, position.X), math.Max(maxX, position.X)
+		minY, maxY = math.Min(minY, position.Y), math.Max(maxY, position.Y)
+	}
+	heatmap.OriginX = math.Floor(minX/cellSize) * cellSize
+	heatmap.OriginY = math.Floor(minY/cellSize) * cellSize
+	// Checked as float, as the cell counts of far apart positions may overflow an int.
+	columnCount := math.Floor((maxX-heatmap.OriginX)/cellSize) + 1
+	rowCount := math.Floor((maxY-heatmap.OriginY)/cellSize) + 1
+	if columnCount*rowCount > maxHeatmapCells {
+		return apiserver.Heatmap{}, fmt.Errorf("%w: %v x %v cells exceed the limit of %v, increase the cell size", conf.ErrBadRequest, columnCount, rowCount, maxHeatmapCells)
+	}
+	columns, rows := int(columnCount), int(rowCount)
+	heatmap.Columns = int32(columns)
+	heatmap.Rows = int32(rows)
+
+	heatmap.Cells = make([][]int64, rows)
+	for row := range heatmap.Cells {
+		heatmap.Cells[row] = make([]int64, columns)
+	}
+	for _, position := range positions {
+		column := int((position.X - heatmap.OriginX) / cellSize)
+		row := int((position.Y - heatmap.OriginY) / cellSize)
+		heatmap.Cells[row][column]++
+		if heatmap.Cells[row][column] > heatmap.MaxCount {
+			heatmap.MaxCount = heatmap.Cells[row][column]
+		}
+	}
+	return heatmap, nil
+}
+
+// RenderHeatmap draws the heatmap as PNG. If the floor plan has an image, the heatmap is drawn
+// over the plan using its geometry. Otherwise only the grid is drawn on a transparent background.
+func RenderHeatmap(heatmap apiserver.Heatmap, floorPlan *appdb.FloorPlan) ([]byte, error) {
+	var canvas *image.NRGBA
+	var toFloor func(px, py float64) (float64, float64)
+	if floorPlan != nil && floorPlan.Image.Valid && floorPlan.Scale > 0 {
+		planConfig, _, err := image.DecodeConfig(bytes.NewReader(floorPlan.Image.Bytes))
+		if err != nil {
+			return nil, fmt.Errorf("decoding floor plan image: %v", err)
+		}
+		if planConfig.Width*planConfig.Height > maxFloorPlanPixels {
+			return nil, fmt.Errorf("floor plan image of %v x %v pixels exceeds the limit of %v pixels", planConfig.Width, planConfig.Height, maxFloorPlanPixels)
+		}
+		plan, _, err := image.Decode(bytes.NewReader(floorPlan.Image.Bytes))
+		if err != nil {
+			return nil, fmt.Errorf("decoding floor plan image: %v", err)
+		}
+		planBounds := plan.Bounds()
+		scale := canvasScale(planBounds.Dx(), planBounds.Dy())
+		if scale == 1 {
+			canvas = image.NewNRGBA(planBounds)
+			draw.Draw(canvas, canvas.Bounds(), plan, planBounds.Min, draw.Src)
+		} else {
+			// Large floor plans are scaled down by nearest neighbour.
+			width := int(math.Max(1, float64(planBounds.Dx())*scale))
+			height := int(math.Max(1, float64(planBounds.Dy())*scale))
+			canvas = image.NewNRGBA(image.Rect(0, 0, width, height))
+			bounds := canvas.Bounds()
+			for py := bounds.Min.Y; py < bounds.Max.Y; py++ {
+				for px := bounds.Min.X; px < bounds.Max.X; px++ {
+					canvas.Set(px, py, plan.At(planBounds.Min.X+int(float64(px)/scale), planBounds.Min.Y+int(float64(py)/scale)))
+				}
+			}
+		}
+		sin, cos := math.Sincos(floorPlan.Rotation * math.Pi / 180)
+		toFloor = func(px, py float64) (float64, float64) {
+			dx := (px/scale - floorPlan.OriginX) / floorPlan.Scale
+			dy := (py/scale - floorPlan.OriginY) / floorPlan.Scale
+			return dx*cos + dy*sin, dy*cos - dx*sin
+		}
+	} else if heatmap.Columns == 0 || heatmap.Rows == 0 {
+		// No positions in the range, PNG doesn't support empty images.
+		canvas = image.NewNRGBA(image.Rect(0, 0, 1, 1))
+		toFloor = func(px, py float64) (float64, float64) { return 0, 0 }
+	} else {
+		cellPixels := gridCellPixels(int(heatmap.Columns) * int(heatmap.Rows))
+		canvas = image.NewNRGBA(image.Rect(0, 0, int(heatmap.Columns)*cellPixels, int(heatmap.Rows)*cellPixels))
+		toFloor = func(px, py float64) (float64, float64) {
+			return heatmap.OriginX + px/float64(cellPixels)*heatmap.CellSize,
+				heatmap.OriginY + py/float64(cellPixels)*heatmap.CellSize
+		}
+	}
+
+	if heatmap.MaxCount > 0 {
+		bounds := canvas.Bounds()
+		for py := bounds.Min.Y; py < bounds.Max.Y; py++ {
+			for px := bounds.Min.X; px < bounds.Max.X; px++ {
+				x, y := toFloor(float64(px)+0.5, float64(py)+0.5)
+				column := int(math.Floor((x - heatmap.OriginX) / heatmap.CellSize))
+				row := int(math.Floor((y - heatmap.OriginY) / heatmap.CellSize))
+				if column < 0 || row < 0 || column >= int(heatmap.Columns) || row >= int(heatmap.Rows) {
+					continue
+				}
+				count := heatmap.Cells[row][column]
+				if count == 0 {
+					continue
+				}
+				canvas.SetNRGBA(px, py, blend(canvas.NRGBAAt(px, py), heatColor(float64(count)/float64(heatmap.MaxCount))))
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, canvas); err != nil {
+		return nil, fmt.Errorf("encoding heatmap: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// gridCellPixels returns the edge length of the cells of a grid rendered without a floor plan, so
+// that the image has at most maxHeatmapPixels.
+func gridCellPixels(cells int) int {
+	pixels := heatmapCellPixels
+	for pixels > 1 && cells*pixels*pixels > maxHeatmapPixels {
+		pixels--
+	}
+	return pixels
+}
+
+// canvasScale returns the factor to scale a floor plan image by, so that it has at most
+// maxHeatmapPixels.
+func canvasScale(width int, height int) float64 {
+	if width*height <= maxHeatmapPixels {
+		return 1
+	}
+	return math.Sqrt(float64(maxHeatmapPixels) / float64(width*height))
+}
+
+// heatColor maps a density between 0 and 1 to a semi-transparent color from blue to red.
+func heatColor(density float64) color.NRGBA {
+	hue := (1 - density) * 240
+	sector := hue / 60
+	fraction := sector - math.Floor(sector)
+	var r, g, b float64
+	switch int(sector) {
+	case 0:
+		r, g, b = 1, fraction, 0
+	case 1:
+		r, g, b = 1-fraction, 1, 0
+	case 2:
+		r, g, b = 0, 1, fraction
+	case 3:
+		r, g, b = 0, 1-fraction, 1
+	default:
+		r, g, b = 0, 0, 1
+	}
+	return color.NRGBA{R: uint8(r * 255), G: uint8(g * 255), B: uint8(b * 255), A: 160}
+}
+
+func blend(background, foreground color.NRGBA) color.NRGBA {
+	alpha := float64(foreground.A) / 255
+	mix := func(bg, fg uint8) uint8 {
+		return uint8(float64(bg)*(1-alpha) + float64(fg)*alpha)
+	}
+	if background.A == 0 {
+		return foreground
+	}
+	return color.NRGBA{
+		R: mix(background.R, foreground.R),
+		G: mix(background.G, foreground.G),
+		B: mix(background.B, foreground.B),
+		A: uint8(math.Max(float64(background.A), float64(foreground.A))),
+	}
+}
@@ -0,0 +1,179 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package tracking
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/png"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/null/v8"
+)
+
+func positionsAt(points ...[2]float64) []*appdb.Position {
+	positions := make([]*appdb.Position, len(points))
+	for i, point := range points {
+		positions[i] = &appdb.Position{X: point[0], Y: point[1]}
+	}
+	return positions
+}
+
+func TestComputeHeatmap(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []*appdb.Position
+		cellSize  float64
+		originX   float64
+		originY   float64
+		cells     [][]int64
+		maxCount  int64
+	}{
+		{
+			name:     "no positions",
+			cellSize: 1,
+			cells:    [][]int64{},
+		},
+		{
+			name:      "single position",
+			positions: positionsAt([2]float64{2.5, 3.5}),
+			cellSize:  1,
+			originX:   2,
+			originY:   3,
+			cells:     [][]int64{{1}},
+			maxCount:  1,
+		},
+		{
+			name:      "origin aligned to cell size",
+			positions: positionsAt([2]float64{1.2, 0.7}, [2]float64{4.9, 2.1}),
+			cellSize:  2,
+			originX:   0,
+			originY:   0,
+			cells:     [][]int64{{1, 0, 0}, {0, 0, 1}},
+			maxCount:  1,
+		},
+		{
+			name:      "negative coordinates",
+			positions: positionsAt([2]float64{-1.5, -0.5}, [2]float64{0.5, 0.5}),
+			cellSize:  1,
+			originX:   -2,
+			originY:   -1,
+			cells:     [][]int64{{1, 0, 0}, {0, 0, 1}},
+			maxCount:  1,
+		},
+		{
+			name:      "cell boundary belongs to the next cell",
+			positions: positionsAt([2]float64{0, 0}, [2]float64{1, 0}, [2]float64{1.5, 0.5}),
+			cellSize:  1,
+			cells:     [][]int64{{1, 2}},
+			maxCount:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			heatmap, err := ComputeHeatmap(7, tt.positions, tt.cellSize)
+			if err != nil {
+				t.Fatalf("ComputeHeatmap() error = %v", err)
+			}
+			if heatmap.FloorId != 7 || heatmap.CellSize != tt.cellSize {
+				t.Errorf("floor %v, cell size %v, want 7, %v", heatmap.FloorId, heatmap.CellSize, tt.cellSize)
+			}
+			if heatmap.OriginX != tt.originX || heatmap.OriginY != tt.originY {
+				t.Errorf("origin (%v, %v), want (%v, %v)", heatmap.OriginX, heatmap.OriginY, tt.originX, tt.originY)
+			}
+			if !reflect.DeepEqual(heatmap.Cells, tt.cells) {
+				t.Errorf("cells %v, want %v", heatmap.Cells, tt.cells)
+			}
+			if int(heatmap.Rows) != len(tt.cells) || (len(tt.cells) > 0 && int(heatmap.Columns) != len(tt.cells[0])) {
+				t.Errorf("%v x %v cells, want %v rows", heatmap.Columns, heatmap.Rows, len(tt.cells))
+			}
+			if heatmap.MaxCount != tt.maxCount {
+				t.Errorf("max count %v, want %v", heatmap.MaxCount, tt.maxCount)
+			}
+		})
+	}
+}
+
+func TestComputeHeatmapErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []*appdb.Position
+		cellSize  float64
+	}{
+		{"zero cell size", nil, 0},
+		{"negative cell size", nil, -1},
+		{"cell size below minimum", nil, minHeatmapCellSize / 2},
+		{"NaN cell size", nil, math.NaN()},
+		{"infinite cell size", nil, math.Inf(1)},
+		{"too many cells", positionsAt([2]float64{0, 0}, [2]float64{1000, 1000}), 1},
+		{"far apart positions", positionsAt([2]float64{-1e300, 0}, [2]float64{1e300, 0}), minHeatmapCellSize},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ComputeHeatmap(7, tt.positions, tt.cellSize); !errors.Is(err, conf.ErrBadRequest) {
+				t.Errorf("ComputeHeatmap() error = %v, want %v", err, conf.ErrBadRequest)
+			}
+		})
+	}
+}
+
+func TestRenderHeatmapScalesLargeFloorPlans(t *testing.T) {
+	plan := image.NewNRGBA(image.Rect(0, 0, 3000, 2000))
+	draw.Draw(plan, plan.Bounds(), image.White, image.Point{}, draw.Src)
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, plan); err != nil {
+		t.Fatal(err)
+	}
+	floorPlan := &appdb.FloorPlan{Image: null.BytesFrom(encoded.Bytes()), Scale: 100}
+	// A single cell covering the plan, which is 30 x 20 meters.
+	heatmap := apiserver.Heatmap{CellSize: 30, Columns: 1, Rows: 1, MaxCount: 1, Cells: [][]int64{{1}}}
+
+	rendered, err := RenderHeatmap(heatmap, floorPlan)
+	if err != nil {
+		t.Fatalf("RenderHeatmap() error = %v", err)
+	}
+	decoded, err := png.Decode(bytes.NewReader(rendered))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bounds := decoded.Bounds()
+	if bounds.Dx()*bounds.Dy() > maxHeatmapPixels {
+		t.Errorf("rendered %v x %v pixels, want at most %v", bounds.Dx(), bounds.Dy(), maxHeatmapPixels)
+	}
+	if ratio := float64(bounds.Dx()) / float64(bounds.Dy()); math.Abs(ratio-1.5) > 0.01 {
+		t.Errorf("aspect ratio %v, want 1.5", ratio)
+	}
+	if r, g, b, _ := decoded.At(bounds.Dx()-1, bounds.Dy()-1).RGBA(); r == g && g == b {
+		t.Errorf("corner of the cell not colored")
+	}
+}
+
+func TestCanvasScale(t *testing.T) {
+	if scale := canvasScale(1000, 1000); scale != 1 {
+		t.Errorf("canvasScale() of small plan = %v, want 1", scale)
+	}
+	scale := canvasScale(8000, 6000)
+	if pixels := int(8000*scale) * int(6000*scale); pixels > maxHeatmapPixels || pixels < maxHeatmapPixels*9/10 {
+		t.Errorf("canvasScale() of large plan scales to %v pixels, want at most %v", pixels, maxHeatmapPixels)
+	}
+}
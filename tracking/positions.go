@@ -0,0 +1,69 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	kontaktio "kontakt-io/kontakt-io"
+	"kontakt-io/metrics"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+// RecordPositions adds the current positions of tracked devices to the position history and
+// removes positions older than the retention period of the configuration.
+func RecordPositions(ctx context.Context, config apiserver.Configuration, devices []kontaktio.Device) error {
+	now := time.Now()
+	recorded := 0
+	for _, device := range devices {
+		if device.WorldPosition == nil {
+			continue
+		}
+		recordedAt := device.PositionTimestamp
+		if recordedAt.IsZero() {
+			recordedAt = now
+		}
+		position := &appdb.Position{
+			ConfigurationID: *config.Id,
+			DeviceID:        device.ID,
+			RecordedAt:      recordedAt,
+			X:               device.PositionX,
+			Y:               device.PositionY,
+		}
+		if device.FloorID != 0 {
+			position.FloorID = null.Int32From(int32(device.FloorID))
+		}
+		if device.RoomID != 0 {
+			position.RoomID = null.Int32From(int32(device.RoomID))
+		}
+		if err := conf.InsertPosition(ctx, position); err != nil {
+			return fmt.Errorf("inserting position of device %s: %v", device.ID, err)
+		}
+		recorded++
+	}
+	metrics.AddPositionsProcessed(*config.Id, recorded)
+
+	retention := time.Duration(*config.PositionRetention) * 24 * time.Hour
+	if _, err := conf.DeletePositionsBefore(ctx, *config.Id, now.Add(-retention)); err != nil {
+		return fmt.Errorf("deleting expired positions: %v", err)
+	}
+	return nil
+}
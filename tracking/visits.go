@@ -0,0 +1,110 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	"kontakt-io/eliona"
+	kontaktio "kontakt-io/kontakt-io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProcessVisits records the room visits of tracked devices. A visit starts when a device is first
+// positioned in a room and ends as soon as the device is positioned elsewhere or not reported anymore.
+func ProcessVisits(ctx context.Context, config apiserver.Configuration, devices []kontaktio.Device) error {
+	openVisits, err := conf.GetOpenRoomVisits(ctx, config)
+	if err != nil {
+		return fmt.Errorf("getting open room visits: %v", err)
+	}
+	visitsByDevice := make(map[string]*appdb.RoomVisit, len(openVisits))
+	for _, visit := range openVisits {
+		visitsByDevice[visit.DeviceID] = visit
+	}
+
+	now := time.Now()
+	for _, device := range devices {
+		if !device.IsTracker() || device.WorldPosition == nil || device.RoomID == 0 {
+			continue
+		}
+		visit, ok := visitsByDevice[device.ID]
+		if ok && visit.RoomID == int32(device.RoomID) {
+			delete(visitsByDevice, device.ID)
+			visit.LastSeenAt = now
+			visit.Duration = int32(now.Sub(visit.EnteredAt).Seconds())
+			if err := conf.UpdateRoomVisit(ctx, visit); err != nil {
+				return fmt.Errorf("updating room visit: %v", err)
+			}
+			continue
+		}
+		visit = &appdb.RoomVisit{
+			ConfigurationID: *config.Id,
+			DeviceID:        device.ID,
+			RoomID:          int32(device.RoomID),
+			EnteredAt:       now,
+			LastSeenAt:      now,
+		}
+		if err := conf.InsertRoomVisit(ctx, visit); err != nil {
+			return fmt.Errorf("inserting room visit: %v", err)
+		}
+	}
+
+	// All visits left over ended since the last cycle.
+	for _, visit := range visitsByDevice {
+		visit.ExitedAt.SetValid(visit.LastSeenAt)
+		visit.Duration = int32(visit.LastSeenAt.Sub(visit.EnteredAt).Seconds())
+		if err := conf.UpdateRoomVisit(ctx, visit); err != nil {
+			return fmt.Errorf("closing room visit: %v", err)
+		}
+	}
+	return nil
+}
+
+// UpsertDailyRoomDwellTimes writes the dwell time and number of visits of the current day to
+// all room assets of the configuration.
+func UpsertDailyRoomDwellTimes(ctx context.Context, config apiserver.Configuration) error {
+	now := time.Now()
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	dwellTimes, err := conf.GetDwellTimes(ctx, *config.Id, startOfDay, now, false)
+	if err != nil {
+		return fmt.Errorf("getting dwell times: %v", err)
+	}
+	byRoom := make(map[string]conf.DwellTime, len(dwellTimes))
+	for _, dwellTime := range dwellTimes {
+		byRoom[dwellTime.Key] = dwellTime
+	}
+
+	rooms, err := conf.GetRoomLocations(ctx, config, kontaktio.RoomAssetType)
+	if err != nil {
+		return fmt.Errorf("getting rooms: %v", err)
+	}
+	for _, room := range rooms {
+		roomId := strings.TrimPrefix(room.GlobalAssetID, kontaktio.RoomAssetType)
+		if _, err := strconv.Atoi(roomId); err != nil {
+			continue
+		}
+		dwellTime := byRoom[roomId]
+		if err := eliona.UpsertRoomDwellTimeData(ctx, room.AssetID.Int32, dwellTime.DwellSeconds, dwellTime.Visits); err != nil {
+			return fmt.Errorf("upserting dwell time of room %v: %v", roomId, err)
+		}
+	}
+	return nil
+}
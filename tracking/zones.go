@@ -0,0 +1,234 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"kontakt-io/apiserver"
+	"kontakt-io/appdb"
+	"kontakt-io/conf"
+	"kontakt-io/eliona"
+	kontaktio "kontakt-io/kontakt-io"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+const (
+	zoneEventEnter         = "enter"
+	zoneEventExit          = "exit"
+	zoneEventDwellExceeded = "dwell_exceeded"
+	zoneEventUnauthorized  = "unauthorized_entry"
+)
+
+// zoneExitGraceIntervals is the number of refresh intervals a device stays in a zone without
+// reporting a position, so that single missing positions don't cause exit and enter events.
+const zoneExitGraceIntervals = 3
+
+type zoneStatus struct {
+	device     kontaktio.Device
+	zoneNames  []string
+	violated   bool
+	raiseAlarm bool
+	// left is set if the device left a zone.
+	left bool
+}
+
+func (status *zoneStatus) add(zone apiserver.Zone, presence *appdb.ZonePresence) {
+	status.zoneNames = append(status.zoneNames, zone.Name)
+	if zone.RaiseAlarm {
+		status.raiseAlarm = true
+		status.violated = status.violated || presence.Unauthorized || presence.DwellExceeded
+	}
+}
+
+// ProcessZones compares the current positions of the tracked devices with the zones of the
+// configuration. Entering and leaving a zone, exceeding the dwell threshold and entries of devices
+// not allowed by the zone filter are written as events to the device assets.
+func ProcessZones(ctx context.Context, config apiserver.Configuration, devices []kontaktio.Device) error {
+	zones, err := conf.GetZones(ctx, *config.Id)
+	if err != nil {
+		return fmt.Errorf("getting zones: %v", err)
+	}
+	if len(zones) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	exitGrace := zoneExitGraceIntervals * time.Duration(config.RefreshInterval) * time.Second
+	statuses := make(map[string]*zoneStatus)
+	// dropped are the statuses of devices inside a zone that are not reported by Kontakt.io
+	// anymore.
+	dropped := make(map[string]*zoneStatus)
+	for _, device := range devices {
+		if device.IsTracker() {
+			statuses[device.ID] = &zoneStatus{device: device}
+		}
+	}
+
+	for _, zone := range zones {
+		presences, err := conf.GetZonePresences(ctx, *zone.Id)
+		if err != nil {
+			return fmt.Errorf("getting presences in zone %v: %v", *zone.Id, err)
+		}
+		present := make(map[string]*appdb.ZonePresence, len(presences))
+		for _, presence := range presences {
+			present[presence.DeviceID] = presence
+		}
+
+		for id, status := range statuses {
+			presence, wasInside := present[id]
+			delete(present, id)
+			inside, known := inZone(zone, status.device)
+			if !known {
+				// No position in this cycle, the device is assumed to be where it was.
+				if wasInside {
+					if err := keepOrLeaveZone(ctx, config, zone, presence, status, now.Add(-exitGrace)); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if !inside {
+				if wasInside {
+					if err := leaveZone(ctx, config, zone, presence, status.device); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			var events []string
+			if !wasInside {
+				presence = &appdb.ZonePresence{
+					ZoneID:     *zone.Id,
+					DeviceID:   id,
+					DeviceType: status.device.Type,
+					EnteredAt:  now,
+				}
+				events = append(events, zoneEventEnter)
+				allowed, err := status.device.AdheresToFilter(zone.AllowedFilter)
+				if err != nil {
+					return fmt.Errorf("checking if device adheres to filter of zone %v: %v", *zone.Id, err)
+				}
+				if !allowed {
+					presence.Unauthorized = true
+					events = append(events, zoneEventUnauthorized)
+				}
+			}
+			presence.LastSeenAt = now
+			if zone.DwellThreshold != nil && !presence.DwellExceeded &&
+				now.Sub(presence.EnteredAt) > time.Duration(*zone.DwellThreshold)*time.Second {
+				presence.DwellExceeded = true
+				events = append(events, zoneEventDwellExceeded)
+			}
+			if err := conf.UpsertZonePresence(ctx, presence); err != nil {
+				return fmt.Errorf("upserting presence in zone %v: %v", *zone.Id, err)
+			}
+			for _, event := range events {
+				if err := eliona.UpsertZoneEvent(ctx, config, status.device, zone.Name, event); err != nil {
+					return fmt.Errorf("upserting zone event: %v", err)
+				}
+			}
+
+			status.add(zone, presence)
+		}
+
+		// Devices that are not reported by Kontakt.io anymore leave the zone after the grace
+		// period as well.
+		for _, presence := range present {
+			status, ok := dropped[presence.DeviceID]
+			if !ok {
+				status = &zoneStatus{device: kontaktio.Device{ID: presence.DeviceID, Type: presence.DeviceType}}
+				dropped[presence.DeviceID] = status
+			}
+			if err := keepOrLeaveZone(ctx, config, zone, presence, status, now.Add(-exitGrace)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, status := range statuses {
+		if err := eliona.UpsertZoneStatus(ctx, config, status.device, status.zoneNames, status.violated, status.raiseAlarm); err != nil {
+			return fmt.Errorf("upserting zone status: %v", err)
+		}
+	}
+	for _, status := range dropped {
+		if !status.left {
+			continue
+		}
+		if err := eliona.UpsertZoneStatus(ctx, config, status.device, status.zoneNames, status.violated, status.raiseAlarm); err != nil {
+			return fmt.Errorf("upserting zone status: %v", err)
+		}
+	}
+	return nil
+}
+
+// keepOrLeaveZone handles a device inside the zone without position in this cycle. It leaves the
+// zone if it was last seen before the given time.
+func keepOrLeaveZone(ctx context.Context, config apiserver.Configuration, zone apiserver.Zone, presence *appdb.ZonePresence, status *zoneStatus, lastSeenBefore time.Time) error {
+	if presence.LastSeenAt.Before(lastSeenBefore) {
+		status.left = true
+		return leaveZone(ctx, config, zone, presence, status.device)
+	}
+	status.add(zone, presence)
+	return nil
+}
+
+func leaveZone(ctx context.Context, config apiserver.Configuration, zone apiserver.Zone, presence *appdb.ZonePresence, device kontaktio.Device) error {
+	if err := conf.DeleteZonePresence(ctx, presence); err != nil {
+		return fmt.Errorf("deleting presence in zone %v: %v", *zone.Id, err)
+	}
+	log.Debug("tracking", "Device %v left zone %v after %v.", device.ID, zone.Name, presence.LastSeenAt.Sub(presence.EnteredAt))
+	if err := eliona.UpsertZoneEvent(ctx, config, device, zone.Name, zoneEventExit); err != nil {
+		return fmt.Errorf("upserting zone event: %v", err)
+	}
+	return nil
+}
+
+// inZone reports whether the device is inside the zone. Known is false if the device reported no
+// position in this cycle.
+func inZone(zone apiserver.Zone, device kontaktio.Device) (inside bool, known bool) {
+	if device.WorldPosition == nil {
+		return false, false
+	}
+	if zone.RoomIds != nil && len(*zone.RoomIds) > 0 {
+		for _, roomId := range *zone.RoomIds {
+			if int(roomId) == device.RoomID {
+				return true, true
+			}
+		}
+		return false, true
+	}
+	if zone.Polygon == nil || zone.FloorId == nil || int(*zone.FloorId) != device.FloorID {
+		return false, true
+	}
+	return pointInPolygon(device.PositionX, device.PositionY, *zone.Polygon), true
+}
+
+// pointInPolygon uses ray casting to determine whether the point lies inside the polygon.
+func pointInPolygon(x, y float64, polygon [][]float64) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		xi, yi := polygon[i][0], polygon[i][1]
+		xj, yj := polygon[j][0], polygon[j][1]
+		if (yi > y) != (yj > y) && x < (xj-xi)*(y-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
@@ -0,0 +1,61 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package tracking
+
+import "testing"
+
+func TestPointInPolygon(t *testing.T) {
+	square := [][]float64{{0, 0}, {10, 0}, {10, 10}, {0, 10}}
+	concave := [][]float64{{0, 0}, {10, 0}, {10, 10}, {5, 5}, {0, 10}}
+	tests := []struct {
+		name    string
+		x, y    float64
+		polygon [][]float64
+		want    bool
+	}{
+		{"inside", 5, 5, square, true},
+		{"outside", 15, 5, square, false},
+		{"outside below", 5, -1, square, false},
+		{"lower edge", 5, 0, square, true},
+		{"left edge", 0, 5, square, true},
+		{"upper edge", 5, 10, square, false},
+		{"right edge", 10, 5, square, false},
+		{"lower left vertex", 0, 0, square, true},
+		{"upper right vertex", 10, 10, square, false},
+		{"concave inside", 2, 4, concave, true},
+		{"concave notch", 5, 8, concave, false},
+		{"empty polygon", 0, 0, [][]float64{}, false},
+		{"line", 5, 0, [][]float64{{0, 0}, {10, 0}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pointInPolygon(tt.x, tt.y, tt.polygon); got != tt.want {
+				t.Errorf("pointInPolygon(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPointInPolygonSharedEdge(t *testing.T) {
+	left := [][]float64{{0, 0}, {10, 0}, {10, 10}, {0, 10}}
+	right := [][]float64{{10, 0}, {20, 0}, {20, 10}, {10, 10}}
+	for _, y := range []float64{0, 5, 9.99} {
+		inLeft, inRight := pointInPolygon(10, y, left), pointInPolygon(10, y, right)
+		if inLeft == inRight {
+			t.Errorf("point (10, %v) in left zone %v and right zone %v, want exactly one", y, inLeft, inRight)
+		}
+	}
+}
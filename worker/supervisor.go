@@ -0,0 +1,404 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"kontakt-io/apiserver"
+	"kontakt-io/metrics"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// Loading the configurations is retried with an exponential backoff between these delays.
+const (
+	minRetryDelay = time.Second
+	maxRetryDelay = time.Minute
+)
+
+// RunFunc does one collection cycle for a configuration. It should return early once the context
+// is canceled.
+type RunFunc func(ctx context.Context, config apiserver.Configuration) error
+
+// LoadFunc returns the configurations that should have a running worker. Errors concerning a
+// single configuration, e.g. failing to maintain its state, are returned per configuration ID and
+// don't prevent its worker from running.
+type LoadFunc func(ctx context.Context) (configs []apiserver.Configuration, configErrors map[int64]error, err error)
+
+// Supervisor runs one worker per configuration. Workers are started, restarted with the new
+// settings and stopped as soon as their configuration is created, changed or deleted.
+type Supervisor struct {
+	run    RunFunc
+	load   LoadFunc
+	reload chan struct{}
+	// observeCycle records the metrics of a cycle, replaceable in tests.
+	observeCycle func(configId int64, scheduledStart time.Time, start time.Time, end time.Time)
+
+	mu      sync.Mutex
+	workers map[int64]*worker
+	// paused are the configurations no worker is started for, counted by WithoutWorker calls.
+	paused map[int64]int
+	// generation is increased by WithoutWorker, so that configurations loaded before are not
+	// used to start workers again.
+	generation int
+
+	// Separate lock, so that recording errors doesn't wait for the workers being synchronized.
+	errMu      sync.Mutex
+	loadErrors map[int64]error
+	runErrors  map[int64]error
+}
+
+type triggerKey struct{}
+
+// TriggerNext makes the worker running the cycle of the context start its next cycle right after
+// the current one, e.g. because more work is pending. Does nothing outside of a cycle.
+func TriggerNext(ctx context.Context) {
+	if trigger, ok := ctx.Value(triggerKey{}).(chan struct{}); ok {
+		select {
+		case trigger <- struct{}{}:
+		default:
+		}
+	}
+}
+
+type worker struct {
+	config apiserver.Configuration
+	cancel context.CancelFunc
+	done   chan struct{}
+	// trigger wakes the worker for an immediate cycle.
+	trigger chan struct{}
+	// stopping is closed to stop the worker after its current cycle.
+	stopping chan struct{}
+	// progress is the time in Unix nanoseconds the worker started or last completed a cycle.
+	progress atomic.Int64
+}
+
+func NewSupervisor(run RunFunc, load LoadFunc) *Supervisor {
+	return &Supervisor{
+		run:          run,
+		load:         load,
+		reload:       make(chan struct{}, 1),
+		observeCycle: metrics.ObserveCycle,
+		workers:      make(map[int64]*worker),
+		paused:       make(map[int64]int),
+		loadErrors:   make(map[int64]error),
+		runErrors:    make(map[int64]error),
+	}
+}
+
+// Run synchronizes the workers with the configurations whenever Reload is called and at least
+// every poll interval, to pick up changes made directly in the database. If the configurations
+// can't be loaded, e.g. while the database is unavailable, the running workers are kept and
+// loading is retried with a backoff. When the context is done, all workers are stopped: their
+// current cycles may finish within the stop grace period and are canceled afterwards.
+func (s *Supervisor) Run(ctx context.Context, pollInterval time.Duration, stopGrace time.Duration) {
+	// The workers are not canceled with the context, so that their cycles can finish their
+	// writes.
+	workCtx, cancelWork := context.WithCancel(context.Background())
+	defer cancelWork()
+	retryDelay := minRetryDelay
+	for {
+		wait := pollInterval
+		generation := s.currentGeneration()
+		configs, configErrors, err := s.load(ctx)
+		if err != nil {
+			log.Error("worker", "loading configurations, retrying in %v: %v", retryDelay, err)
+			wait = retryDelay
+			retryDelay = nextRetryDelay(retryDelay)
+		} else {
+			retryDelay = minRetryDelay
+			s.sync(workCtx, configs, generation)
+			s.setLoadErrors(configErrors)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.stopAll(stopGrace)
+			return
+		case <-s.reload:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+func nextRetryDelay(delay time.Duration) time.Duration {
+	delay *= 2
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+// Err returns the error maintaining the state of the configuration or, if none, the error of the
+// last collection cycle of its worker.
+func (s *Supervisor) Err(configId int64) error {
+	s.errMu.Lock()
+	defer s.errMu.Unlock()
+	if err := s.loadErrors[configId]; err != nil {
+		return err
+	}
+	return s.runErrors[configId]
+}
+
+func (s *Supervisor) setLoadErrors(configErrors map[int64]error) {
+	s.errMu.Lock()
+	defer s.errMu.Unlock()
+	s.loadErrors = make(map[int64]error, len(configErrors))
+	for id, err := range configErrors {
+		s.loadErrors[id] = err
+	}
+}
+
+func (s *Supervisor) setRunError(configId int64, err error) {
+	s.errMu.Lock()
+	defer s.errMu.Unlock()
+	if err == nil {
+		delete(s.runErrors, configId)
+		return
+	}
+	s.runErrors[configId] = err
+}
+
+// Reload makes Run synchronize the workers without waiting for the next poll.
+func (s *Supervisor) Reload() {
+	select {
+	case s.reload <- struct{}{}:
+	default:
+		// A reload is already pending.
+	}
+}
+
+// WithoutWorker stops the worker of the configuration, waits until its in-flight work is
+// canceled and calls f, e.g. to delete the configuration. No worker is started for the
+// configuration while f runs, nor from configurations loaded before f returned.
+func (s *Supervisor) WithoutWorker(configId int64, f func() error) error {
+	s.mu.Lock()
+	w, ok := s.workers[configId]
+	if ok {
+		w.cancel()
+		delete(s.workers, configId)
+	}
+	s.paused[configId]++
+	s.generation++
+	s.mu.Unlock()
+
+	if ok {
+		<-w.done
+	}
+	err := f()
+
+	s.mu.Lock()
+	s.paused[configId]--
+	if s.paused[configId] == 0 {
+		delete(s.paused, configId)
+	}
+	s.generation++
+	s.mu.Unlock()
+	s.Reload()
+	return err
+}
+
+// Trigger starts a cycle of the configuration's worker as soon as its current cycle, if any, is
+// finished. Triggers while a cycle is pending are coalesced. Reports false if no worker runs for
+// the configuration, e.g. because it is disabled.
+func (s *Supervisor) Trigger(configId int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, ok := s.workers[configId]
+	if !ok {
+		return false
+	}
+	select {
+	case w.trigger <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// Stalled returns the IDs of the configurations whose worker has neither started nor completed a
+// cycle within the duration maxAge returns for the configuration.
+func (s *Supervisor) Stalled(maxAge func(config apiserver.Configuration) time.Duration) []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	var stalled []int64
+	for id, w := range s.workers {
+		if now.Sub(time.Unix(0, w.progress.Load())) > maxAge(w.config) {
+			stalled = append(stalled, id)
+		}
+	}
+	sort.Slice(stalled, func(i, j int) bool { return stalled[i] < stalled[j] })
+	return stalled
+}
+
+func (s *Supervisor) currentGeneration() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.generation
+}
+
+// sync starts, restarts and stops the workers according to the configurations. Workers are
+// canceled under the lock but waited for outside of it, a restarted worker starts its first cycle
+// once its predecessor returned.
+func (s *Supervisor) sync(ctx context.Context, configs []apiserver.Configuration, generation int) {
+	s.mu.Lock()
+	if generation != s.generation {
+		// The configurations are outdated, a reload is pending.
+		s.mu.Unlock()
+		return
+	}
+	wanted := make(map[int64]bool, len(configs))
+	for _, config := range configs {
+		if config.Id == nil {
+			continue
+		}
+		id := *config.Id
+		wanted[id] = true
+		var predecessor <-chan struct{}
+		if w, ok := s.workers[id]; ok {
+			if sameSettings(w.config, config) {
+				continue
+			}
+			log.Info("worker", "Configuration %d changed, restarting its worker.", id)
+			w.cancel()
+			predecessor = w.done
+		} else if s.paused[id] > 0 {
+			continue
+		}
+		s.workers[id] = s.start(ctx, config, predecessor)
+	}
+	stopped := make(map[int64]*worker)
+	for id, w := range s.workers {
+		if !wanted[id] {
+			log.Info("worker", "Configuration %d removed or disabled, stopping its worker.", id)
+			w.cancel()
+			delete(s.workers, id)
+			stopped[id] = w
+		}
+	}
+	s.mu.Unlock()
+
+	for id, w := range stopped {
+		<-w.done
+		s.setRunError(id, nil)
+	}
+}
+
+// stopAll stops the workers after their current cycle, or cancels them after the grace period.
+func (s *Supervisor) stopAll(grace time.Duration) {
+	s.mu.Lock()
+	workers := s.workers
+	s.workers = make(map[int64]*worker)
+	s.mu.Unlock()
+
+	for _, w := range workers {
+		close(w.stopping)
+	}
+	graceCtx, cancel := context.WithTimeout(context.Background(), grace)
+	defer cancel()
+	for _, w := range workers {
+		select {
+		case <-w.done:
+		case <-graceCtx.Done():
+			w.stop()
+		}
+	}
+}
+
+// start runs a worker for the configuration. Its first cycle waits until the predecessor, if any,
+// returned.
+func (s *Supervisor) start(ctx context.Context, config apiserver.Configuration, predecessor <-chan struct{}) *worker {
+	ctx, cancel := context.WithCancel(ctx)
+	w := &worker{
+		config:   config,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+		trigger:  make(chan struct{}, 1),
+		stopping: make(chan struct{}),
+	}
+	w.progress.Store(time.Now().UnixNano())
+	ctx = context.WithValue(ctx, triggerKey{}, w.trigger)
+	go func() {
+		defer close(w.done)
+		if predecessor != nil {
+			// The predecessor is canceled and returns soon.
+			<-predecessor
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.stopping:
+				return
+			default:
+			}
+		}
+		// scheduledStart is the start of the previous cycle plus the refresh interval, or the time
+		// the cycle was triggered. Zero for the first cycle.
+		var scheduledStart time.Time
+		for {
+			start := time.Now()
+			err := s.run(ctx, config)
+			s.observeCycle(*config.Id, scheduledStart, start, time.Now())
+			w.progress.Store(time.Now().UnixNano())
+			if ctx.Err() != nil {
+				// Canceled by a restart or the shutdown, the error is not meaningful.
+				return
+			}
+			s.setRunError(*config.Id, err)
+			select {
+			case <-w.stopping:
+				return
+			default:
+			}
+			// Cycles taking longer than the refresh interval are followed by the next one right away.
+			scheduledStart = start.Add(time.Duration(config.RefreshInterval) * time.Second)
+			timer := time.NewTimer(time.Until(scheduledStart))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-w.stopping:
+				timer.Stop()
+				return
+			case <-w.trigger:
+				timer.Stop()
+				scheduledStart = time.Now()
+			case <-timer.C:
+			}
+		}
+	}()
+	return w
+}
+
+// stop cancels the worker and waits until it returned.
+func (w *worker) stop() {
+	w.cancel()
+	<-w.done
+}
+
+// sameSettings compares the configurations ignoring the active state, which is maintained by the
+// app itself.
+func sameSettings(a, b apiserver.Configuration) bool {
+	a.Active = nil
+	b.Active = nil
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,272 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"kontakt-io/apiserver"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRun records the cycles of the workers. Each cycle runs until it is canceled.
+type fakeRun struct {
+	mu      sync.Mutex
+	started []apiserver.Configuration
+	running map[int64]int
+	overlap bool
+	cycles  chan apiserver.Configuration
+}
+
+func newFakeRun() *fakeRun {
+	return &fakeRun{running: make(map[int64]int), cycles: make(chan apiserver.Configuration, 10)}
+}
+
+func (f *fakeRun) run(ctx context.Context, config apiserver.Configuration) error {
+	f.mu.Lock()
+	f.started = append(f.started, config)
+	f.running[*config.Id]++
+	if f.running[*config.Id] > 1 {
+		f.overlap = true
+	}
+	f.mu.Unlock()
+	f.cycles <- config
+
+	<-ctx.Done()
+	// Lingering cleanup of a canceled cycle.
+	time.Sleep(10 * time.Millisecond)
+
+	f.mu.Lock()
+	f.running[*config.Id]--
+	f.mu.Unlock()
+	return ctx.Err()
+}
+
+func (f *fakeRun) startedCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.started)
+}
+
+func (f *fakeRun) waitForCycle(t *testing.T) apiserver.Configuration {
+	t.Helper()
+	select {
+	case config := <-f.cycles:
+		return config
+	case <-time.After(time.Second):
+		t.Fatal("no cycle started")
+		return apiserver.Configuration{}
+	}
+}
+
+func (f *fakeRun) expectNoCycle(t *testing.T) {
+	t.Helper()
+	select {
+	case config := <-f.cycles:
+		t.Fatalf("unexpected cycle of configuration %d", *config.Id)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func noLoad(context.Context) ([]apiserver.Configuration, map[int64]error, error) {
+	return nil, nil, nil
+}
+
+func (s *Supervisor) workerCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.workers)
+}
+
+func testConfig(id int64, refreshInterval int32) apiserver.Configuration {
+	return apiserver.Configuration{Id: &id, RefreshInterval: refreshInterval}
+}
+
+func TestSyncStartsAndStopsWorkers(t *testing.T) {
+	run := newFakeRun()
+	s := NewSupervisor(run.run, noLoad)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.sync(ctx, []apiserver.Configuration{testConfig(1, 60)}, s.currentGeneration())
+	if config := run.waitForCycle(t); *config.Id != 1 {
+		t.Fatalf("started configuration %d, want 1", *config.Id)
+	}
+	s.setRunError(1, context.DeadlineExceeded)
+
+	s.sync(ctx, nil, s.currentGeneration())
+	if count := s.workerCount(); count != 0 {
+		t.Errorf("%d workers left, want none", count)
+	}
+	if err := s.Err(1); err != nil {
+		t.Errorf("error of removed configuration kept: %v", err)
+	}
+}
+
+func TestSyncRestartsChangedConfiguration(t *testing.T) {
+	run := newFakeRun()
+	s := NewSupervisor(run.run, noLoad)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.sync(ctx, []apiserver.Configuration{testConfig(1, 60)}, s.currentGeneration())
+	run.waitForCycle(t)
+
+	// Unchanged settings keep the worker.
+	active := true
+	unchanged := testConfig(1, 60)
+	unchanged.Active = &active
+	s.sync(ctx, []apiserver.Configuration{unchanged}, s.currentGeneration())
+	run.expectNoCycle(t)
+
+	s.sync(ctx, []apiserver.Configuration{testConfig(1, 120)}, s.currentGeneration())
+	if config := run.waitForCycle(t); config.RefreshInterval != 120 {
+		t.Errorf("restarted with refresh interval %d, want 120", config.RefreshInterval)
+	}
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	if run.overlap {
+		t.Error("restarted worker ran while its predecessor was still running")
+	}
+}
+
+func TestSyncIgnoresOutdatedConfigurations(t *testing.T) {
+	run := newFakeRun()
+	s := NewSupervisor(run.run, noLoad)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	generation := s.currentGeneration()
+	if err := s.WithoutWorker(1, func() error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	// Loaded before the configuration was deleted.
+	s.sync(ctx, []apiserver.Configuration{testConfig(1, 60)}, generation)
+	run.expectNoCycle(t)
+	if run.startedCount() != 0 {
+		t.Errorf("started %d cycles from outdated configurations", run.startedCount())
+	}
+}
+
+func TestWithoutWorkerRacingWithSync(t *testing.T) {
+	run := newFakeRun()
+	s := NewSupervisor(run.run, noLoad)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	configs := []apiserver.Configuration{testConfig(1, 60)}
+	s.sync(ctx, configs, s.currentGeneration())
+	run.waitForCycle(t)
+
+	var loadedDuringDelete int
+	err := s.WithoutWorker(1, func() error {
+		run.mu.Lock()
+		running := run.running[1]
+		run.mu.Unlock()
+		if running != 0 {
+			t.Errorf("%d cycles running while deleting", running)
+		}
+		// A synchronization during the deletion, with the configuration still in the database.
+		loadedDuringDelete = s.currentGeneration()
+		s.sync(ctx, configs, loadedDuringDelete)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	run.expectNoCycle(t)
+
+	// Loaded during the deletion, but synchronized after it.
+	s.sync(ctx, configs, loadedDuringDelete)
+	run.expectNoCycle(t)
+	if count := s.workerCount(); count != 0 {
+		t.Errorf("%d workers started for the deleted configuration", count)
+	}
+
+	// Loaded after the deletion, e.g. if the configuration was recreated.
+	s.sync(ctx, configs, s.currentGeneration())
+	run.waitForCycle(t)
+}
+
+func TestTriggerStartsCycle(t *testing.T) {
+	var mu sync.Mutex
+	cycles := 0
+	triggered := make(chan struct{}, 10)
+	run := func(ctx context.Context, config apiserver.Configuration) error {
+		mu.Lock()
+		cycles++
+		mu.Unlock()
+		triggered <- struct{}{}
+		return nil
+	}
+	s := NewSupervisor(run, noLoad)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if s.Trigger(1) {
+		t.Error("triggered configuration without worker")
+	}
+	s.sync(ctx, []apiserver.Configuration{testConfig(1, 3600)}, s.currentGeneration())
+	<-triggered
+	if !s.Trigger(1) {
+		t.Fatal("trigger not delivered")
+	}
+	select {
+	case <-triggered:
+	case <-time.After(time.Second):
+		t.Fatal("triggered cycle didn't start")
+	}
+	s.stopAll(time.Second)
+	mu.Lock()
+	defer mu.Unlock()
+	if cycles != 2 {
+		t.Errorf("%d cycles, want 2", cycles)
+	}
+}
+
+func TestCycleLagOfSlowCycle(t *testing.T) {
+	const slowCycle = 1500 * time.Millisecond
+	cycles := 0
+	run := func(ctx context.Context, config apiserver.Configuration) error {
+		cycles++
+		if cycles == 1 {
+			// Longer than the refresh interval, so the next cycle starts late.
+			time.Sleep(slowCycle)
+		}
+		return nil
+	}
+	lags := make(chan time.Duration, 10)
+	s := NewSupervisor(run, noLoad)
+	s.observeCycle = func(configId int64, scheduledStart time.Time, start time.Time, end time.Time) {
+		if !scheduledStart.IsZero() {
+			lags <- start.Sub(scheduledStart)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.sync(ctx, []apiserver.Configuration{testConfig(1, 1)}, s.currentGeneration())
+	select {
+	case lag := <-lags:
+		if want := slowCycle - time.Second; lag < want-100*time.Millisecond || lag > want+400*time.Millisecond {
+			t.Errorf("lag %v, want about %v", lag, want)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("second cycle didn't start")
+	}
+	s.stopAll(time.Second)
+}